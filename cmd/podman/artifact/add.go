@@ -21,7 +21,8 @@ var addCmd = &cobra.Command{
 	ValidArgsFunction: common.AutocompleteArtifactAdd,
 	Example: `podman artifact add quay.io/myimage/myartifact:latest /tmp/foobar.txt
 podman artifact add --file-type text/yaml quay.io/myimage/myartifact:latest /tmp/foobar.yaml
-podman artifact add --append quay.io/myimage/myartifact:latest /tmp/foobar.tar.gz`,
+podman artifact add --append quay.io/myimage/myartifact:latest /tmp/foobar.tar.gz
+podman artifact add --replace-file quay.io/myimage/myartifact:latest /tmp/foobar.yaml`,
 }
 
 // AddOptionsWrapper wraps entities.ArtifactsAddOptions and prevents leaking
@@ -54,6 +55,9 @@ func init() {
 	replaceFlagName := "replace"
 	flags.BoolVar(&addOpts.Replace, replaceFlagName, false, "Replace an existing artifact")
 
+	replaceFileFlagName := "replace-file"
+	flags.BoolVar(&addOpts.ReplaceFile, replaceFileFlagName, false, "Replace files with the same name in an existing artifact")
+
 	fileMIMETypeFlagName := "file-type"
 	flags.StringVarP(&addOpts.FileMIMEType, fileMIMETypeFlagName, "", "", "Set file type to use for the artifact (layer)")
 	_ = addCmd.RegisterFlagCompletionFunc(fileMIMETypeFlagName, completion.AutocompleteNone)
@@ -66,6 +70,9 @@ func add(_ *cobra.Command, args []string) error {
 	if addOpts.Append && addOpts.Replace {
 		return fmt.Errorf("--append and --replace options cannot be used together")
 	}
+	if addOpts.ReplaceFile && (addOpts.Append || addOpts.Replace) {
+		return fmt.Errorf("--replace-file cannot be used with the --append or --replace options")
+	}
 
 	annots, err := utils.ParseAnnotations(addOpts.AnnotationsCLI)
 	if err != nil {
@@ -78,6 +85,7 @@ func add(_ *cobra.Command, args []string) error {
 		Append:           addOpts.Append,
 		FileMIMEType:     addOpts.FileMIMEType,
 		Replace:          addOpts.Replace,
+		ReplaceFile:      addOpts.ReplaceFile,
 	}
 
 	artifactBlobs := make([]entities.ArtifactBlob, 0, len(blobs))
@@ -0,0 +1,169 @@
+package artifact
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/cmd/podman/utils"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	specV1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/libartifact"
+	"go.podman.io/common/pkg/report"
+)
+
+var (
+	diffCmd = &cobra.Command{
+		Use:               "diff [options] ARTIFACT ARTIFACT",
+		Short:             "Compare the files of two OCI artifacts",
+		Long:              "List the files which were added, removed or changed between two OCI artifacts. Files are compared by their digest and annotations.",
+		RunE:              diff,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteArtifacts,
+		Example: `podman artifact diff quay.io/myimage/myartifact:v1 quay.io/myimage/myartifact:v2
+podman artifact diff --format json quay.io/myimage/myartifact:v1 quay.io/myimage/myartifact:v2`,
+	}
+	diffFormat string
+)
+
+// Kinds of changes to the files of an artifact.
+const (
+	artifactFileAdded   = "added"
+	artifactFileRemoved = "removed"
+	artifactFileChanged = "changed"
+)
+
+// artifactFileChange describes a file which differs between two artifacts.
+type artifactFileChange struct {
+	// Name is the title of the file, or the digest of the blob for files
+	// without a title.
+	Name string
+	// Kind is one of added, removed or changed.
+	Kind string
+	// OldDigest is the digest of the file in the first artifact.
+	OldDigest string `json:",omitempty"`
+	// NewDigest is the digest of the file in the second artifact.
+	NewDigest string `json:",omitempty"`
+	// Annotations are the keys of the annotations which were added,
+	// removed or changed.
+	Annotations []string `json:",omitempty"`
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: diffCmd,
+		Parent:  artifactCmd,
+	})
+
+	flags := diffCmd.Flags()
+	formatFlagName := "format"
+	flags.StringVar(&diffFormat, formatFlagName, "", "Change the output format (json)")
+	_ = diffCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(nil))
+}
+
+func diff(_ *cobra.Command, args []string) error {
+	if diffFormat != "" && !report.IsJSON(diffFormat) {
+		return errors.New("only supported value for '--format' is 'json'")
+	}
+
+	artifacts := make([]*libartifact.Artifact, 0, len(args))
+	for _, name := range args {
+		inspectData, err := registry.ImageEngine().ArtifactInspect(registry.Context(), name, entities.ArtifactInspectOptions{})
+		if err != nil {
+			return err
+		}
+		artifacts = append(artifacts, inspectData.Artifact)
+	}
+
+	changes := artifactDiff(artifacts[0], artifacts[1])
+	if report.IsJSON(diffFormat) {
+		return utils.PrintGenericJSON(changes)
+	}
+	// Use the same letters as podman diff
+	letters := map[string]string{
+		artifactFileAdded:   "A",
+		artifactFileChanged: "C",
+		artifactFileRemoved: "D",
+	}
+	for _, change := range changes {
+		fmt.Printf("%s %s\n", letters[change.Kind], change.Name)
+	}
+	return nil
+}
+
+// artifactDiff returns the changes to the files of the artifact from to the
+// artifact to, sorted by name.
+func artifactDiff(from, to *libartifact.Artifact) []artifactFileChange {
+	oldFiles := artifactFiles(from)
+	newFiles := artifactFiles(to)
+
+	changes := []artifactFileChange{}
+	for name, oldLayer := range oldFiles {
+		newLayer, ok := newFiles[name]
+		if !ok {
+			changes = append(changes, artifactFileChange{
+				Name:      name,
+				Kind:      artifactFileRemoved,
+				OldDigest: oldLayer.Digest.String(),
+			})
+			continue
+		}
+		annotations := changedAnnotations(oldLayer.Annotations, newLayer.Annotations)
+		if oldLayer.Digest != newLayer.Digest || len(annotations) > 0 {
+			changes = append(changes, artifactFileChange{
+				Name:        name,
+				Kind:        artifactFileChanged,
+				OldDigest:   oldLayer.Digest.String(),
+				NewDigest:   newLayer.Digest.String(),
+				Annotations: annotations,
+			})
+		}
+	}
+	for name, newLayer := range newFiles {
+		if _, ok := oldFiles[name]; !ok {
+			changes = append(changes, artifactFileChange{
+				Name:      name,
+				Kind:      artifactFileAdded,
+				NewDigest: newLayer.Digest.String(),
+			})
+		}
+	}
+	slices.SortFunc(changes, func(a, b artifactFileChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return changes
+}
+
+// artifactFiles returns the layers of the artifact by file name.
+func artifactFiles(arty *libartifact.Artifact) map[string]specV1.Descriptor {
+	files := make(map[string]specV1.Descriptor, len(arty.Manifest.Layers))
+	for _, layer := range arty.Manifest.Layers {
+		name := layer.Annotations[specV1.AnnotationTitle]
+		if name == "" {
+			name = layer.Digest.String()
+		}
+		files[name] = layer
+	}
+	return files
+}
+
+// changedAnnotations returns the sorted keys of the annotations which differ.
+func changedAnnotations(from, to map[string]string) []string {
+	var keys []string
+	for key, value := range from {
+		if newValue, ok := to[key]; !ok || newValue != value {
+			keys = append(keys, key)
+		}
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
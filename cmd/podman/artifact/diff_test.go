@@ -0,0 +1,74 @@
+package artifact
+
+import (
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	specV1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"go.podman.io/common/pkg/libartifact"
+	"go.podman.io/image/v5/manifest"
+)
+
+func testArtifact(layers ...specV1.Descriptor) *libartifact.Artifact {
+	return &libartifact.Artifact{
+		Manifest: &manifest.OCI1{Manifest: specV1.Manifest{Layers: layers}},
+	}
+}
+
+func testLayer(title, content string, annotations ...string) specV1.Descriptor {
+	layer := specV1.Descriptor{
+		Digest:      digest.FromString(content),
+		Annotations: map[string]string{},
+	}
+	if title != "" {
+		layer.Annotations[specV1.AnnotationTitle] = title
+	}
+	for i := 0; i+1 < len(annotations); i += 2 {
+		layer.Annotations[annotations[i]] = annotations[i+1]
+	}
+	return layer
+}
+
+func TestArtifactDiff(t *testing.T) {
+	from := testArtifact(
+		testLayer("config.yaml", "a"),
+		testLayer("model.bin", "b", "version", "1"),
+		testLayer("old.txt", "c"),
+		testLayer("", "untitled"),
+	)
+	to := testArtifact(
+		testLayer("config.yaml", "a2"),
+		testLayer("model.bin", "b", "version", "2", "new", "x"),
+		testLayer("new.txt", "c"),
+		testLayer("", "untitled"),
+	)
+
+	want := []artifactFileChange{
+		{
+			Name:      "config.yaml",
+			Kind:      artifactFileChanged,
+			OldDigest: digest.FromString("a").String(),
+			NewDigest: digest.FromString("a2").String(),
+		},
+		{
+			Name:        "model.bin",
+			Kind:        artifactFileChanged,
+			OldDigest:   digest.FromString("b").String(),
+			NewDigest:   digest.FromString("b").String(),
+			Annotations: []string{"new", "version"},
+		},
+		{
+			Name:      "new.txt",
+			Kind:      artifactFileAdded,
+			NewDigest: digest.FromString("c").String(),
+		},
+		{
+			Name:      "old.txt",
+			Kind:      artifactFileRemoved,
+			OldDigest: digest.FromString("c").String(),
+		},
+	}
+	assert.Equal(t, want, artifactDiff(from, to))
+	assert.Empty(t, artifactDiff(from, from))
+}
@@ -31,14 +31,14 @@ var (
   or similar units that create new containers in order to run the updated images.
   Please refer to the podman-auto-update(1) man page for details.`
 	autoUpdateCommand = &cobra.Command{
-		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
 		Use:               "auto-update [options]",
 		Short:             "Auto update containers according to their auto-update policy",
 		Long:              autoUpdateDescription,
 		RunE:              autoUpdate,
 		ValidArgsFunction: completion.AutocompleteNone,
 		Example: `podman auto-update
-  podman auto-update --authfile ~/authfile.json`,
+  podman auto-update --authfile ~/authfile.json
+  podman auto-update --canary --batch-size 5 --soak-time 1m`,
 	}
 )
 
@@ -56,6 +56,20 @@ func init() {
 	flags.BoolVar(&autoUpdateOptions.DryRun, "dry-run", false, "Check for pending updates")
 	flags.BoolVar(&autoUpdateOptions.Rollback, "rollback", true, "Rollback to previous image if update fails")
 
+	waitHealthyFlagName := "wait-healthy"
+	flags.DurationVar(&autoUpdateOptions.WaitHealthy, waitHealthyFlagName, 0, "Time to wait for updated containers with a healthcheck to become healthy before considering the update failed")
+	_ = autoUpdateCommand.RegisterFlagCompletionFunc(waitHealthyFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&autoUpdateOptions.Canary, "canary", false, "Update a single unit first before updating the others in a staged rollout")
+
+	batchSizeFlagName := "batch-size"
+	flags.IntVar(&autoUpdateOptions.BatchSize, batchSizeFlagName, 0, "Number of units to update per stage of a staged rollout, 0 for all")
+	_ = autoUpdateCommand.RegisterFlagCompletionFunc(batchSizeFlagName, completion.AutocompleteNone)
+
+	soakTimeFlagName := "soak-time"
+	flags.DurationVar(&autoUpdateOptions.SoakTime, soakTimeFlagName, 0, "Time the units of a rollout stage must keep running before updating the next stage")
+	_ = autoUpdateCommand.RegisterFlagCompletionFunc(soakTimeFlagName, completion.AutocompleteNone)
+
 	flags.StringVar(&autoUpdateOptions.format, "format", "", "Change the output format to JSON or a Go template")
 	_ = autoUpdateCommand.RegisterFlagCompletionFunc("format", common.AutocompleteFormat(&autoUpdateOutput{}))
 
@@ -73,6 +87,9 @@ func autoUpdate(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	}
+	if autoUpdateOptions.BatchSize < 0 {
+		return fmt.Errorf("invalid batch size %d: must not be negative", autoUpdateOptions.BatchSize)
+	}
 	if cmd.Flags().Changed("tls-verify") {
 		autoUpdateOptions.InsecureSkipTLSVerify = types.NewOptionalBool(!autoUpdateOptions.tlsVerify)
 	}
@@ -97,6 +114,8 @@ type autoUpdateOutput struct {
 	Image         string
 	Policy        string
 	Updated       string
+	Stage         int
+	Health        string
 }
 
 func reportsToOutput(allReports []*entities.AutoUpdateReport) []autoUpdateOutput {
@@ -110,6 +129,8 @@ func reportsToOutput(allReports []*entities.AutoUpdateReport) []autoUpdateOutput
 			Image:         r.ImageName,
 			Policy:        r.Policy,
 			Updated:       r.Updated,
+			Stage:         r.Stage,
+			Health:        r.Health,
 		}
 	}
 	return output
@@ -745,6 +745,17 @@ func AutocompleteSecretCreate(_ *cobra.Command, args []string, _ string) ([]stri
 	return nil, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteSecretUpdate - Autocomplete the secret and then the file with the new data.
+func AutocompleteSecretUpdate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return AutocompleteSecrets(cmd, args, toComplete)
+	case 1:
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteImages - Autocomplete images.
 func AutocompleteImages(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if !validCurrentCmdLine(cmd, args, toComplete) {
@@ -1084,6 +1095,17 @@ func AutocompleteScp(cmd *cobra.Command, args []string, toComplete string) ([]st
 	return nil, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteContainerMigrate - Autocomplete a running container and a system connection.
+func AutocompleteContainerMigrate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return AutocompleteContainersRunning(cmd, args, toComplete)
+	case 1:
+		return AutocompleteSystemConnections(cmd, args, toComplete)
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 /* -------------- Flags ----------------- */
 
 // AutocompleteDetachKeys - Autocomplete detach-keys options.
@@ -1605,8 +1627,9 @@ func AutocompleteEventFilter(cmd *cobra.Command, _ []string, toComplete string)
 			events.NetworkDisconnect.String(), events.Pause.String(), events.Prune.String(), events.Pull.String(),
 			events.PullError.String(), events.Push.String(), events.Refresh.String(), events.Remove.String(),
 			events.Rename.String(), events.Renumber.String(), events.Restart.String(), events.Restore.String(),
-			events.Save.String(), events.Start.String(), events.Stop.String(), events.Sync.String(), events.Tag.String(),
+			events.Rollback.String(), events.Save.String(), events.Start.String(), events.Stop.String(), events.Sync.String(), events.Tag.String(),
 			events.Unmount.String(), events.Unpause.String(), events.Untag.String(), events.Update.String(),
+			events.UpdateUnhealthy.String(),
 		}, cobra.ShellCompDirectiveNoFileComp
 	}
 	eventTypes := func(_ string) ([]string, cobra.ShellCompDirective) {
@@ -1660,6 +1683,13 @@ func AutocompleteManifestFormat(_ *cobra.Command, _ []string, _ string) ([]strin
 	return types, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteNetworkExportFormat - Autocomplete network export format options.
+// -> "json", "yaml"
+func AutocompleteNetworkExportFormat(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	formats := []string{"json", "yaml"}
+	return formats, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteNetworkDriver - Autocomplete network driver option.
 func AutocompleteNetworkDriver(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 	engine, err := setupContainerEngine(cmd)
@@ -1906,6 +1936,17 @@ func AutocompleteNetworkFilters(cmd *cobra.Command, _ []string, toComplete strin
 	return completeKeyValues(toComplete, kv)
 }
 
+// AutocompleteLogFilters - Autocomplete logs --filter options.
+func AutocompleteLogFilters(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	kv := keyValueCompletion{
+		"partial=": getBoolCompletion,
+		"stream=": func(_ string) ([]string, cobra.ShellCompDirective) {
+			return []string{"stdout", "stderr"}, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+	return completeKeyValues(toComplete, kv)
+}
+
 // AutocompleteNetworkInterfaceNames - Autocomplete network create --interface-name options.
 func AutocompleteNetworkInterfaceNames(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 	interfaces, err := net.Interfaces()
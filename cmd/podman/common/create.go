@@ -110,6 +110,14 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 			"This is a Docker specific option and is a NOOP",
 		)
 
+		egressAllowFlagName := "egress-allow"
+		createFlags.StringArrayVar(
+			&cf.EgressAllow,
+			egressAllowFlagName, []string{},
+			"Only allow the container to send traffic to the given host or network (`host[:port]`)",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(egressAllowFlagName, completion.AutocompleteNone)
+
 		envMergeFlagName := "env-merge"
 		createFlags.StringArrayVar(
 			&cf.EnvMerge,
@@ -330,6 +338,22 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(requiresFlagName, AutocompleteContainers)
 
+		requiresHealthyFlagName := "requires-healthy"
+		createFlags.StringSliceVar(
+			&cf.RequiresHealthy,
+			requiresHealthyFlagName, []string{},
+			"Add one or more requirement containers that must be healthy before this container will start",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(requiresHealthyFlagName, AutocompleteContainers)
+
+		requiresHealthyTimeoutFlagName := "requires-healthy-timeout"
+		createFlags.StringVar(
+			&cf.RequiresHealthyTimeout,
+			requiresHealthyTimeoutFlagName, "",
+			"Maximum time to wait for --requires-healthy containers to become healthy (default "+define.DefaultHealthyDependencyTimeout+")",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(requiresHealthyTimeoutFlagName, completion.AutocompleteNone)
+
 		retryFlagName := "retry"
 		createFlags.Uint(retryFlagName, registry.RetryDefault(), "number of times to retry in case of failure when performing pull")
 		_ = cmd.RegisterFlagCompletionFunc(retryFlagName, completion.AutocompleteNone)
@@ -655,6 +679,14 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(healthOnFailureFlagName, AutocompleteHealthOnFailure)
 
+		healthOnFailureHookFlagName := "health-on-failure-hook"
+		createFlags.StringVar(
+			&cf.HealthOnFailureHook,
+			healthOnFailureHookFlagName, "",
+			"command or http(s) URL to notify once the container turns unhealthy with the notify on-failure action",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(healthOnFailureHookFlagName, completion.AutocompleteDefault)
+
 		// Startup HealthCheck
 
 		startupHCCmdFlagName := "health-startup-cmd"
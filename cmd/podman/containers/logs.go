@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/containers/podman/v6/cmd/podman/common"
 	"github.com/containers/podman/v6/cmd/podman/registry"
@@ -23,6 +24,8 @@ type logsOptionsWrapper struct {
 	SinceRaw string
 
 	UntilRaw string
+
+	FilterRaw []string
 }
 
 var (
@@ -54,6 +57,7 @@ var (
   podman logs --names ctrID1 ctrID2
   podman logs --tail 2 mywebserver
   podman logs --follow=true --since 10m ctrID
+  podman logs --grep 'timeout|refused' --filter stream=stderr ctrID
   podman logs mywebserver mydbserver`,
 	}
 
@@ -114,6 +118,14 @@ func logsFlags(cmd *cobra.Command) {
 	flags.Int64Var(&logsOptions.Tail, tailFlagName, -1, "Output the specified number of LINES at the end of the logs.  Defaults to -1, which prints all lines")
 	_ = cmd.RegisterFlagCompletionFunc(tailFlagName, completion.AutocompleteNone)
 
+	grepFlagName := "grep"
+	flags.StringVar(&logsOptions.Grep, grepFlagName, "", "Only output log lines whose message matches the regular expression")
+	_ = cmd.RegisterFlagCompletionFunc(grepFlagName, completion.AutocompleteNone)
+
+	filterFlagName := "filter"
+	flags.StringArrayVar(&logsOptions.FilterRaw, filterFlagName, nil, "Only output log lines whose fields match the filter (e.g. stream=stderr, level=error)")
+	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompleteLogFilters)
+
 	flags.BoolVarP(&logsOptions.Timestamps, "timestamps", "t", false, "Output the timestamps in the log")
 	flags.BoolVarP(&logsOptions.Colors, "color", "", false, "Output the containers with different colors in the log.")
 	flags.BoolVarP(&logsOptions.Names, "names", "n", false, "Output the container name in the log")
@@ -139,6 +151,16 @@ func logs(_ *cobra.Command, args []string) error {
 		}
 		logsOptions.Until = until
 	}
+	if len(logsOptions.FilterRaw) > 0 {
+		logsOptions.Filters = make(map[string][]string)
+		for _, f := range logsOptions.FilterRaw {
+			name, value, ok := strings.Cut(f, "=")
+			if !ok || name == "" {
+				return fmt.Errorf("invalid filter %q, must be in the form field=value", f)
+			}
+			logsOptions.Filters[name] = append(logsOptions.Filters[name], value)
+		}
+	}
 	logsOptions.StdoutWriter = os.Stdout
 	logsOptions.StderrWriter = os.Stderr
 	return registry.ContainerEngine().ContainerLogs(registry.Context(), args, logsOptions.ContainerLogsOptions)
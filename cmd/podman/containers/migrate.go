@@ -0,0 +1,74 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/criu"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/common/pkg/ssh"
+)
+
+var (
+	migrateDescription = `Moves a running container to the host of a system connection.
+
+  The memory of the container is transferred in pre-checkpoints while the container keeps running, so it is only stopped for the transfer of the final checkpoint. The container is removed locally once it runs on the remote host.`
+
+	migrateCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "migrate [options] CONTAINER CONNECTION",
+		Short:             "Migrate a running container to another host",
+		Long:              migrateDescription,
+		RunE:              migrate,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteContainerMigrate,
+		Example: `podman container migrate ctrID server2
+  podman container migrate --pre-dumps 3 --tcp-established ctrID server2`,
+	}
+)
+
+var migrateOptions entities.ContainerMigrateOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: migrateCommand,
+		Parent:  containerCmd,
+	})
+	flags := migrateCommand.Flags()
+
+	preDumpsFlagName := "pre-dumps"
+	flags.IntVar(&migrateOptions.PreDumps, preDumpsFlagName, 1, "Number of pre-checkpoints transferred while the container keeps running")
+	_ = migrateCommand.RegisterFlagCompletionFunc(preDumpsFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&migrateOptions.TCPEstablished, "tcp-established", false, "Migrate a container with established TCP connections")
+	flags.BoolVar(&migrateOptions.FileLocks, "file-locks", false, "Migrate a container with file locks")
+	flags.BoolVar(&migrateOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not transfer root file-system changes")
+	flags.BoolVar(&migrateOptions.IgnoreVolumes, "ignore-volumes", false, "Do not transfer volumes associated with the container")
+	flags.BoolVar(&migrateOptions.IgnoreStaticIP, "ignore-static-ip", false, "Ignore IP address set via --static-ip")
+	flags.BoolVar(&migrateOptions.IgnoreStaticMAC, "ignore-static-mac", false, "Ignore MAC address set via --mac-address")
+}
+
+func migrate(_ *cobra.Command, args []string) error {
+	if rootless.IsRootless() {
+		return errors.New("migrating a container requires root")
+	}
+	if migrateOptions.PreDumps < 0 {
+		return fmt.Errorf("invalid number of pre-dumps %d", migrateOptions.PreDumps)
+	}
+	if migrateOptions.PreDumps > 0 && !criu.MemTrack() {
+		return errors.New("system (architecture/kernel/CRIU) does not support memory tracking, use --pre-dumps=0")
+	}
+	migrateOptions.SSHMode = ssh.DefineMode(registry.PodmanConfig().SSHMode)
+
+	report, err := registry.ContainerEngine().ContainerMigrate(registry.Context(), args[0], args[1], migrateOptions)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.RemoteId)
+	return nil
+}
@@ -36,7 +36,10 @@ var (
 	}
 )
 
-var restoreOptions entities.RestoreOptions
+var (
+	restoreOptions entities.RestoreOptions
+	importPrevious []string
+)
 
 type restoreStatistics struct {
 	PodmanDuration      int64                     `json:"podman_restore_duration"`
@@ -64,7 +67,7 @@ func init() {
 	_ = restoreCommand.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
 
 	importPreviousFlagName := "import-previous"
-	flags.StringVar(&restoreOptions.ImportPrevious, importPreviousFlagName, "", "Restore from exported pre-checkpoint archive (tar.gz)")
+	flags.StringArrayVar(&importPrevious, importPreviousFlagName, nil, "Restore from exported pre-checkpoint archive (tar.gz)")
 	_ = restoreCommand.RegisterFlagCompletionFunc(importPreviousFlagName, completion.AutocompleteDefault)
 
 	flags.BoolVar(&restoreOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not apply root file-system changes when importing from exported checkpoint")
@@ -125,9 +128,13 @@ func restore(cmd *cobra.Command, args []string) error {
 
 	notImport := !restoreOptions.CheckpointImage && restoreOptions.Import == ""
 
-	if notImport && restoreOptions.ImportPrevious != "" {
+	if notImport && len(importPrevious) > 0 {
 		return fmt.Errorf("--import-previous can only be used with image or --import")
 	}
+	if len(importPrevious) > 0 {
+		restoreOptions.ImportPrevious = importPrevious[0]
+		restoreOptions.ImportPreviousChain = importPrevious[1:]
+	}
 	if notImport && restoreOptions.IgnoreRootFS {
 		return fmt.Errorf("--ignore-rootfs can only be used with image or --import")
 	}
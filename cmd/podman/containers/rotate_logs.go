@@ -0,0 +1,65 @@
+package containers
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/cmd/podman/utils"
+	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rotateLogsDescription = `Rotates the log files of containers created with the max-file log option once they reached their maximum size or age.
+
+  The IDs of the containers whose log files were rotated are printed.`
+
+	rotateLogsCommand = &cobra.Command{
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:         "rotate-logs [options] CONTAINER [CONTAINER...]",
+		Short:       "Rotate the log files of one or more containers",
+		Long:        rotateLogsDescription,
+		RunE:        rotateLogs,
+		Args: func(cmd *cobra.Command, args []string) error {
+			return validate.CheckAllLatestAndIDFile(cmd, args, false, "")
+		},
+		ValidArgsFunction: common.AutocompleteContainers,
+		Example: `podman container rotate-logs ctrID
+  podman container rotate-logs --all`,
+	}
+)
+
+var rotateLogsOptions entities.ContainerRotateLogsOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: rotateLogsCommand,
+		Parent:  containerCmd,
+	})
+	flags := rotateLogsCommand.Flags()
+	flags.BoolVarP(&rotateLogsOptions.All, "all", "a", false, "Rotate the log files of all containers")
+	validate.AddLatestFlag(rotateLogsCommand, &rotateLogsOptions.Latest)
+}
+
+func rotateLogs(_ *cobra.Command, args []string) error {
+	var errs utils.OutputErrors
+	args = utils.RemoveSlash(args)
+	reports, err := registry.ContainerEngine().ContainerRotateLogs(registry.Context(), args, rotateLogsOptions)
+	if err != nil {
+		return err
+	}
+	for _, r := range reports {
+		switch {
+		case r.Err != nil:
+			errs = append(errs, r.Err)
+		case !r.Rotated:
+		case r.RawInput != "":
+			fmt.Println(r.RawInput)
+		default:
+			fmt.Println(r.Id)
+		}
+	}
+	return errs.PrintErrors()
+}
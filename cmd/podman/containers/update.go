@@ -83,6 +83,9 @@ func GetChangedHealthCheckConfiguration(cmd *cobra.Command, vals *entities.Conta
 	if cmd.Flags().Changed("health-on-failure") {
 		updateHealthCheckConfig.HealthOnFailure = &vals.HealthOnFailure
 	}
+	if cmd.Flags().Changed("health-on-failure-hook") {
+		updateHealthCheckConfig.HealthOnFailureHook = &vals.HealthOnFailureHook
+	}
 	if cmd.Flags().Changed("no-healthcheck") {
 		updateHealthCheckConfig.NoHealthCheck = &vals.NoHealthCheck
 	}
@@ -0,0 +1,44 @@
+package kube
+
+import (
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+)
+
+var (
+	runCronJobOptions = entities.KubeCronJobRunOptions{}
+
+	// Command: podman kube _run-cronjob_
+	// Run by the systemd timers (or the internal scheduler) of CronJobs
+	// created by podman kube play.
+	runCronJobCmd = &cobra.Command{
+		Use:               "run-cronjob [options] CRONJOB",
+		Short:             "Run a CronJob created by podman kube play",
+		Long:              "Creates a Job from the job template of a CronJob created by podman kube play.",
+		Hidden:            true,
+		RunE:              runCronJob,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example:           `podman kube run-cronjob backup`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: runCronJobCmd,
+		Parent:  kubeCmd,
+	})
+
+	flags := runCronJobCmd.Flags()
+	flags.StringVar(&runCronJobOptions.ConcurrencyPolicy, "concurrency-policy", "Allow", "How to treat concurrent runs: Allow, Forbid or Replace")
+	flags.Int32Var(&runCronJobOptions.SuccessfulJobsHistoryLimit, "successful-jobs-history-limit", 3, "Number of successful runs to keep")
+	flags.Int32Var(&runCronJobOptions.FailedJobsHistoryLimit, "failed-jobs-history-limit", 1, "Number of failed runs to keep")
+	flags.StringVar(&runCronJobOptions.Schedule, "schedule", "", "Keep running the CronJob on the cron schedule")
+	flags.StringVar(&runCronJobOptions.TimeZone, "time-zone", "", "Time zone of the cron schedule")
+}
+
+func runCronJob(_ *cobra.Command, args []string) error {
+	return registry.ContainerEngine().KubeCronJobRun(registry.Context(), args[0], runCronJobOptions)
+}
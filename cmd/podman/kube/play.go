@@ -50,7 +50,7 @@ var (
 	playOptions        = playKubeOptionsWrapper{}
 	playDescription    = `Reads in a structured file of Kubernetes YAML.
 
-  Creates pods or volumes based on the Kubernetes kind described in the YAML. Supported kinds are Pods, Deployments, DaemonSets, Jobs, and PersistentVolumeClaims.`
+  Creates pods or volumes based on the Kubernetes kind described in the YAML. Supported kinds are Pods, Deployments, DaemonSets, StatefulSets, Jobs, and PersistentVolumeClaims.`
 
 	playCmd = &cobra.Command{
 		Use:               "play [options] [KUBEFILE [KUBEFILE...]]|-",
@@ -181,6 +181,10 @@ func playFlags(cmd *cobra.Command) {
 	noPodPrefix := "no-pod-prefix"
 	flags.BoolVar(&playOptions.NoPodPrefix, noPodPrefix, false, "Do not prefix container name with pod name")
 
+	readyTimeoutFlagName := "ready-timeout"
+	flags.DurationVar(&playOptions.ReadyTimeout, readyTimeoutFlagName, entities.DefaultPlayKubeReadyTimeout, "Maximum time to wait for a StatefulSet replica to become ready before starting the next one")
+	_ = cmd.RegisterFlagCompletionFunc(readyTimeoutFlagName, completion.AutocompleteNone)
+
 	if !registry.IsRemote() {
 		certDirFlagName := "cert-dir"
 		flags.StringVar(&playOptions.CertDir, certDirFlagName, "", "`Pathname` of a directory containing TLS certificates and keys")
@@ -533,6 +537,14 @@ func printPlayReport(report *entities.PlayKubeReport) error {
 		fmt.Println(secret.CreateReport.ID)
 	}
 
+	// Print CronJobs report
+	for i, cronJob := range report.CronJobs {
+		if i == 0 {
+			fmt.Println("CronJobs:")
+		}
+		fmt.Println(cronJob.Name)
+	}
+
 	// Print pods report
 	for _, pod := range report.Pods {
 		for _, l := range pod.Logs {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/containers/podman/v6/cmd/podman/common"
 	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/spf13/cobra"
 	"go.podman.io/common/libnetwork/types"
@@ -29,6 +30,10 @@ var (
 	ipv4                  net.IP
 	ipv6                  net.IP
 	macAddress            string
+	ingressRate           string
+	egressRate            string
+	latency               string
+	loss                  string
 )
 
 func networkConnectFlags(cmd *cobra.Command) {
@@ -48,6 +53,22 @@ func networkConnectFlags(cmd *cobra.Command) {
 	macAddressFlagName := "mac-address"
 	flags.StringVar(&macAddress, macAddressFlagName, "", "set a static mac address for this container network")
 	_ = cmd.RegisterFlagCompletionFunc(macAddressFlagName, completion.AutocompleteNone)
+
+	ingressRateFlagName := "ingress-rate"
+	flags.StringVar(&ingressRate, ingressRateFlagName, "", "limit the rate of traffic received by the container on this network")
+	_ = cmd.RegisterFlagCompletionFunc(ingressRateFlagName, completion.AutocompleteNone)
+
+	egressRateFlagName := "egress-rate"
+	flags.StringVar(&egressRate, egressRateFlagName, "", "limit the rate of traffic sent by the container on this network")
+	_ = cmd.RegisterFlagCompletionFunc(egressRateFlagName, completion.AutocompleteNone)
+
+	latencyFlagName := "latency"
+	flags.StringVar(&latency, latencyFlagName, "", "add latency to traffic sent by the container on this network")
+	_ = cmd.RegisterFlagCompletionFunc(latencyFlagName, completion.AutocompleteNone)
+
+	lossFlagName := "loss"
+	flags.StringVar(&loss, lossFlagName, "", "drop a percentage of the packets sent by the container on this network")
+	_ = cmd.RegisterFlagCompletionFunc(lossFlagName, completion.AutocompleteNone)
 }
 
 func init() {
@@ -72,6 +93,21 @@ func networkConnect(_ *cobra.Command, args []string) error {
 			networkConnectOptions.StaticIPs = append(networkConnectOptions.StaticIPs, ip)
 		}
 	}
+	qos := map[string]string{
+		define.NetworkQoSIngressRate: ingressRate,
+		define.NetworkQoSEgressRate:  egressRate,
+		define.NetworkQoSLatency:     latency,
+		define.NetworkQoSLoss:        loss,
+	}
+	for option, value := range qos {
+		if value == "" {
+			continue
+		}
+		if networkConnectOptions.Options == nil {
+			networkConnectOptions.Options = make(map[string]string)
+		}
+		networkConnectOptions.Options[option] = value
+	}
 
 	return registry.ContainerEngine().NetworkConnect(registry.Context(), args[0], networkConnectOptions)
 }
@@ -0,0 +1,111 @@
+package network
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/libnetwork/types"
+	"go.podman.io/common/pkg/completion"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	networkExportDescription = `Export the definitions of networks to JSON or YAML.
+
+  The definitions can be used by "podman network import" to recreate the networks on another host. Without a network name all networks except the default network are exported.`
+	networkExportCommand = &cobra.Command{
+		Use:               "export [options] [NETWORK...]",
+		Short:             "Export network definitions",
+		Long:              networkExportDescription,
+		RunE:              networkExport,
+		ValidArgsFunction: common.AutocompleteNetworks,
+		Example: `podman network export --output networks.json
+  podman network export --format yaml net1 net2 > networks.yaml`,
+	}
+)
+
+var (
+	networkExportFormat string
+	networkExportOutput string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: networkExportCommand,
+		Parent:  networkCmd,
+	})
+	flags := networkExportCommand.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&networkExportFormat, formatFlagName, "json", "Format of the network definitions (json or yaml)")
+	_ = networkExportCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteNetworkExportFormat)
+
+	outputFlagName := "output"
+	flags.StringVarP(&networkExportOutput, outputFlagName, "o", "", "Write to a specified file (default: stdout)")
+	_ = networkExportCommand.RegisterFlagCompletionFunc(outputFlagName, completion.AutocompleteDefault)
+}
+
+func networkExport(_ *cobra.Command, args []string) error {
+	var networks []types.Network
+	if len(args) == 0 {
+		list, err := registry.ContainerEngine().NetworkList(registry.Context(), entities.NetworkListOptions{})
+		if err != nil {
+			return err
+		}
+		for _, network := range list {
+			if network.Name != containerConfig.Network.DefaultNetwork {
+				networks = append(networks, network)
+			}
+		}
+	} else {
+		reports, errs, err := registry.ContainerEngine().NetworkInspect(registry.Context(), args, entities.InspectOptions{})
+		if err != nil {
+			return err
+		}
+		if len(errs) > 0 {
+			return errorhandling.JoinErrors(errs)
+		}
+		for _, report := range reports {
+			networks = append(networks, report.Network)
+		}
+	}
+
+	// The ID and creation time are assigned by the host the network is
+	// imported on.
+	for i := range networks {
+		networks[i].ID = ""
+		networks[i].Created = time.Time{}
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	switch networkExportFormat {
+	case "json":
+		data, err = json.MarshalIndent(networks, "", "     ")
+		data = append(data, '\n')
+	case "yaml":
+		data, err = yaml.Marshal(networks)
+	default:
+		return fmt.Errorf("unsupported format %q, must be json or yaml", networkExportFormat)
+	}
+	if err != nil {
+		return err
+	}
+
+	if networkExportOutput == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(networkExportOutput, data, 0o644); err != nil {
+		return fmt.Errorf("unable to write network definitions: %w", err)
+	}
+	return nil
+}
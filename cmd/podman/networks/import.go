@@ -0,0 +1,170 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"github.com/containers/podman/v6/cmd/podman/parse"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/libnetwork/types"
+	"go.podman.io/common/pkg/completion"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	networkImportDescription = `Create networks from definitions written by "podman network export".
+
+  The definitions are read as JSON or YAML. No network is created when a network with the same name already exists or a subnet overlaps with the subnet of an existing network.`
+	networkImportCommand = &cobra.Command{
+		Use:               "import [options] FILE",
+		Short:             "Import network definitions",
+		Long:              networkImportDescription,
+		RunE:              networkImport,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Example: `podman network import networks.json
+  cat networks.yaml | podman network import -`,
+	}
+)
+
+var networkImportIgnore bool
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: networkImportCommand,
+		Parent:  networkCmd,
+	})
+	flags := networkImportCommand.Flags()
+	flags.BoolVar(&networkImportIgnore, "ignore", false, "Skip networks which already exist")
+}
+
+func networkImport(_ *cobra.Command, args []string) error {
+	var (
+		data []byte
+		err  error
+	)
+	if args[0] == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		if err := parse.ValidateFileName(args[0]); err != nil {
+			return err
+		}
+		data, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read network definitions: %w", err)
+	}
+
+	// JSON is valid YAML, the YAML is converted to JSON before it is
+	// decoded so the JSON field names apply to both.
+	var networks []types.Network
+	if err := yaml.Unmarshal(data, &networks); err != nil {
+		return fmt.Errorf("unable to parse network definitions: %w", err)
+	}
+	if len(networks) == 0 {
+		return errors.New("no network definitions found")
+	}
+
+	existing, err := registry.ContainerEngine().NetworkList(registry.Context(), entities.NetworkListOptions{})
+	if err != nil {
+		return err
+	}
+	networks, err = checkNetworkImport(networks, existing, networkImportIgnore)
+	if err != nil {
+		return err
+	}
+
+	for _, network := range networks {
+		network.ID = ""
+		network.Created = time.Time{}
+		created, err := registry.ContainerEngine().NetworkCreate(registry.Context(), network, nil)
+		if err != nil {
+			return fmt.Errorf("creating network %s: %w", network.Name, err)
+		}
+		fmt.Println(created.Name)
+	}
+	return nil
+}
+
+// checkNetworkImport returns the networks to create.  All name and subnet
+// conflicts with the existing networks and between the imported networks
+// are reported at once so that nothing is created when one exists.  Bridge
+// interface names which are already used are dropped and assigned again by
+// the network backend.
+func checkNetworkImport(networks, existing []types.Network, ignore bool) ([]types.Network, error) {
+	names := make(map[string]bool, len(existing))
+	interfaces := make(map[string]bool, len(existing))
+	var subnets []importedSubnet
+	for _, network := range existing {
+		names[network.Name] = true
+		if network.Driver == types.BridgeNetworkDriver {
+			interfaces[network.NetworkInterface] = true
+		}
+		subnets = appendImportedSubnets(subnets, network)
+	}
+
+	var (
+		errs   []error
+		create []types.Network
+	)
+	for _, network := range networks {
+		if network.Name == "" {
+			errs = append(errs, errors.New("network definition without name"))
+			continue
+		}
+		if names[network.Name] {
+			if !ignore {
+				errs = append(errs, fmt.Errorf("network %s already exists", network.Name))
+			}
+			continue
+		}
+		names[network.Name] = true
+
+		if network.Driver == types.BridgeNetworkDriver || network.Driver == "" {
+			// like the network backend, allow the subnets of vlan
+			// networks to overlap
+			for _, subnet := range network.Subnets {
+				for _, used := range subnets {
+					if network.Options[types.VLANOption] == "" && subnetsOverlap(&subnet.Subnet.IPNet, used.subnet) {
+						errs = append(errs, fmt.Errorf("subnet %s of network %s overlaps with subnet %s of network %s", subnet.Subnet.String(), network.Name, used.subnet.String(), used.network))
+					}
+				}
+			}
+			if interfaces[network.NetworkInterface] {
+				network.NetworkInterface = ""
+			}
+			if network.NetworkInterface != "" {
+				interfaces[network.NetworkInterface] = true
+			}
+		}
+		subnets = appendImportedSubnets(subnets, network)
+		create = append(create, network)
+	}
+	if len(errs) > 0 {
+		return nil, errorhandling.JoinErrors(errs)
+	}
+	return create, nil
+}
+
+type importedSubnet struct {
+	network string
+	subnet  *net.IPNet
+}
+
+func appendImportedSubnets(subnets []importedSubnet, network types.Network) []importedSubnet {
+	for _, subnet := range network.Subnets {
+		subnets = append(subnets, importedSubnet{network: network.Name, subnet: &subnet.Subnet.IPNet})
+	}
+	return subnets
+}
+
+func subnetsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
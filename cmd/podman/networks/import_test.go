@@ -0,0 +1,81 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.podman.io/common/libnetwork/types"
+)
+
+func testNetwork(t *testing.T, name, iface string, subnets ...string) types.Network {
+	network := types.Network{Name: name, Driver: types.BridgeNetworkDriver, NetworkInterface: iface}
+	for _, subnet := range subnets {
+		ipnet, err := types.ParseCIDR(subnet)
+		assert.NoError(t, err, "ParseCIDR(%s)", subnet)
+		network.Subnets = append(network.Subnets, types.Subnet{Subnet: ipnet})
+	}
+	return network
+}
+
+func TestCheckNetworkImport(t *testing.T) {
+	existing := []types.Network{
+		testNetwork(t, "podman", "podman0", "10.88.0.0/16"),
+		testNetwork(t, "net1", "podman1", "10.89.0.0/24"),
+	}
+
+	tests := []struct {
+		name     string
+		networks []types.Network
+		ignore   bool
+		want     []types.Network
+		wantErr  string
+	}{
+		{
+			name:     "new network",
+			networks: []types.Network{testNetwork(t, "net2", "podman2", "10.89.1.0/24")},
+			want:     []types.Network{testNetwork(t, "net2", "podman2", "10.89.1.0/24")},
+		},
+		{
+			name:     "used interface name",
+			networks: []types.Network{testNetwork(t, "net2", "podman1", "10.89.1.0/24")},
+			want:     []types.Network{testNetwork(t, "net2", "", "10.89.1.0/24")},
+		},
+		{
+			name:     "existing name",
+			networks: []types.Network{testNetwork(t, "net1", "podman1", "10.89.0.0/24")},
+			wantErr:  "network net1 already exists",
+		},
+		{
+			name:     "existing name ignored",
+			networks: []types.Network{testNetwork(t, "net1", "podman1", "10.89.0.0/24"), testNetwork(t, "net2", "podman2")},
+			ignore:   true,
+			want:     []types.Network{testNetwork(t, "net2", "podman2")},
+		},
+		{
+			name:     "overlapping existing subnet",
+			networks: []types.Network{testNetwork(t, "net2", "podman2", "10.88.1.0/24")},
+			wantErr:  "subnet 10.88.1.0/24 of network net2 overlaps with subnet 10.88.0.0/16 of network podman",
+		},
+		{
+			name:     "overlapping imported subnet",
+			networks: []types.Network{testNetwork(t, "net2", "podman2", "10.90.0.0/16"), testNetwork(t, "net3", "podman3", "10.90.1.0/24")},
+			wantErr:  "subnet 10.90.1.0/24 of network net3 overlaps with subnet 10.90.0.0/16 of network net2",
+		},
+		{
+			name:     "duplicate imported name",
+			networks: []types.Network{testNetwork(t, "net2", "podman2"), testNetwork(t, "net2", "podman3")},
+			wantErr:  "network net2 already exists",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkNetworkImport(tt.networks, existing, tt.ignore)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package pods
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/storage/pkg/archive"
+)
+
+var (
+	podCheckpointDescription = `Checkpoints all running and paused containers of a pod into a single archive.
+
+  All containers are paused before the first one is checkpointed, so the checkpoints reflect the pod at a single point in time. The archive includes the configuration of the pod and its infra container and can be restored with "podman pod restore".`
+
+	checkpointCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "checkpoint [options] POD",
+		Short:             "Checkpoint a pod into an archive",
+		Long:              podCheckpointDescription,
+		RunE:              checkpoint,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompletePodsRunning,
+		Example: `podman pod checkpoint --export mypod.tar.zst mypod
+  podman pod checkpoint --leave-running --export mypod.tar.zst mypod`,
+	}
+)
+
+var checkpointOptions entities.PodCheckpointOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: checkpointCommand,
+		Parent:  podCmd,
+	})
+	flags := checkpointCommand.Flags()
+
+	exportFlagName := "export"
+	flags.StringVarP(&checkpointOptions.Export, exportFlagName, "e", "", "Export the checkpoint of the pod to an archive")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(exportFlagName, completion.AutocompleteDefault)
+	_ = checkpointCommand.MarkFlagRequired(exportFlagName)
+
+	flags.BoolVarP(&checkpointOptions.LeaveRunning, "leave-running", "R", false, "Leave the pod running after writing the checkpoint")
+	flags.BoolVar(&checkpointOptions.TCPEstablished, "tcp-established", false, "Checkpoint containers with established TCP connections")
+	flags.BoolVar(&checkpointOptions.FileLocks, "file-locks", false, "Checkpoint containers with file locks")
+	flags.BoolVar(&checkpointOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not include root file-system changes when exporting")
+	flags.BoolVar(&checkpointOptions.IgnoreVolumes, "ignore-volumes", false, "Do not export volumes associated with the containers")
+
+	flags.StringP("compress", "c", "zstd", "Select compression algorithm (gzip, none, zstd) for checkpoint archive.")
+	_ = checkpointCommand.RegisterFlagCompletionFunc("compress", common.AutocompleteCheckpointCompressType)
+}
+
+func checkpoint(cmd *cobra.Command, args []string) error {
+	compress, _ := cmd.Flags().GetString("compress")
+	switch strings.ToLower(compress) {
+	case "none":
+		checkpointOptions.Compression = archive.Uncompressed
+	case "gzip":
+		checkpointOptions.Compression = archive.Gzip
+	case "zstd":
+		checkpointOptions.Compression = archive.Zstd
+	default:
+		return fmt.Errorf("selected compression algorithm (%q) not supported. Please select one from: gzip, none, zstd", compress)
+	}
+	if rootless.IsRootless() {
+		return errors.New("checkpointing a pod requires root")
+	}
+
+	report, err := registry.ContainerEngine().PodCheckpoint(registry.Context(), args[0], checkpointOptions)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.Id)
+	return nil
+}
@@ -0,0 +1,73 @@
+package pods
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+)
+
+var (
+	podRestoreDescription = `Restores a pod and its containers from an archive written by "podman pod checkpoint".
+
+  The pod keeps its name and ID unless a new name is specified, in which case the pod and its containers get new IDs and the containers are renamed after the pod.`
+
+	restoreCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "restore [options]",
+		Short:             "Restore a pod from a checkpoint archive",
+		Long:              podRestoreDescription,
+		RunE:              restore,
+		Args:              validate.NoArgs,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman pod restore --import mypod.tar.zst
+  podman pod restore --import mypod.tar.zst --name mypod-copy`,
+	}
+)
+
+var restoreOptions entities.PodRestoreOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: restoreCommand,
+		Parent:  podCmd,
+	})
+	flags := restoreCommand.Flags()
+
+	importFlagName := "import"
+	flags.StringVarP(&restoreOptions.Import, importFlagName, "i", "", "Restore from a pod checkpoint archive")
+	_ = restoreCommand.RegisterFlagCompletionFunc(importFlagName, completion.AutocompleteDefault)
+	_ = restoreCommand.MarkFlagRequired(importFlagName)
+
+	nameFlagName := "name"
+	flags.StringVarP(&restoreOptions.Name, nameFlagName, "n", "", "Specify new name for the restored pod")
+	_ = restoreCommand.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&restoreOptions.TCPEstablished, "tcp-established", false, "Restore containers with established TCP connections")
+	flags.BoolVar(&restoreOptions.FileLocks, "file-locks", false, "Restore containers with file locks")
+	flags.BoolVar(&restoreOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not apply root file-system changes when importing from the checkpoint")
+	flags.BoolVar(&restoreOptions.IgnoreVolumes, "ignore-volumes", false, "Do not restore volumes associated with the containers")
+	flags.BoolVar(&restoreOptions.IgnoreStaticIP, "ignore-static-ip", false, "Ignore IP address set via --static-ip")
+	flags.BoolVar(&restoreOptions.IgnoreStaticMAC, "ignore-static-mac", false, "Ignore MAC address set via --mac-address")
+}
+
+func restore(_ *cobra.Command, _ []string) error {
+	if rootless.IsRootless() {
+		return errors.New("restoring a pod requires root")
+	}
+	if restoreOptions.Name != "" && restoreOptions.TCPEstablished {
+		return errors.New("--tcp-established cannot be used with --name")
+	}
+
+	report, err := registry.ContainerEngine().PodRestore(registry.Context(), restoreOptions)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.Id)
+	return nil
+}
@@ -0,0 +1,156 @@
+package pods
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/parse"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/specgen"
+	"github.com/containers/podman/v6/pkg/specgenutil"
+	"github.com/containers/podman/v6/pkg/util"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+)
+
+var (
+	podUpdateDescription = `Updates the resource limits, restart policy and labels of an existing pod.
+
+  Resource limits are applied to the pod cgroup immediately. The restart policy is applied to the infra container and to all containers using the pod's restart policy.`
+
+	updateCommand = &cobra.Command{
+		Use:               "update [options] POD",
+		Short:             "Update an existing pod",
+		Long:              podUpdateDescription,
+		RunE:              update,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompletePods,
+		Example: `podman pod update --cpus=2 --memory=1g mypod
+  podman pod update --restart=on-failure:3 --label env=prod mypod`,
+	}
+)
+
+var (
+	updateResources   entities.ContainerCreateOptions
+	updateRestart     string
+	updateLabels      []string
+	updateUnsetLabels []string
+)
+
+// resourceFlags are the flags changing the resource limits of the pod cgroup.
+var resourceFlags = []string{"cpus", "cpu-shares", "cpuset-cpus", "cpuset-mems", "memory", "memory-swap", "pids-limit"}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: updateCommand,
+		Parent:  podCmd,
+	})
+	flags := updateCommand.Flags()
+
+	updateResources.MemorySwappiness = -1
+
+	cpusFlagName := "cpus"
+	flags.Float64Var(&updateResources.CPUS, cpusFlagName, 0, "Number of CPUs delegated to the pod")
+	_ = updateCommand.RegisterFlagCompletionFunc(cpusFlagName, completion.AutocompleteNone)
+
+	cpuSharesFlagName := "cpu-shares"
+	flags.Uint64VarP(&updateResources.CPUShares, cpuSharesFlagName, "c", 0, "CPU shares (relative weight)")
+	_ = updateCommand.RegisterFlagCompletionFunc(cpuSharesFlagName, completion.AutocompleteNone)
+
+	cpusetCpusFlagName := "cpuset-cpus"
+	flags.StringVar(&updateResources.CPUSetCPUs, cpusetCpusFlagName, "", "CPUs in which to allow execution (0-3, 0,1)")
+	_ = updateCommand.RegisterFlagCompletionFunc(cpusetCpusFlagName, completion.AutocompleteNone)
+
+	cpusetMemsFlagName := "cpuset-mems"
+	flags.StringVar(&updateResources.CPUSetMems, cpusetMemsFlagName, "", "Memory nodes (MEMs) in which to allow execution (0-3, 0,1). Only effective on NUMA systems.")
+	_ = updateCommand.RegisterFlagCompletionFunc(cpusetMemsFlagName, completion.AutocompleteNone)
+
+	memoryFlagName := "memory"
+	flags.StringVarP(&updateResources.Memory, memoryFlagName, "m", "", "Memory limit (format: <number>[<unit>], where unit = b (bytes), k (kibibytes), m (mebibytes), or g (gibibytes))")
+	_ = updateCommand.RegisterFlagCompletionFunc(memoryFlagName, completion.AutocompleteNone)
+
+	memorySwapFlagName := "memory-swap"
+	flags.StringVar(&updateResources.MemorySwap, memorySwapFlagName, "", "Swap limit equal to memory plus swap: '-1' to enable unlimited swap")
+	_ = updateCommand.RegisterFlagCompletionFunc(memorySwapFlagName, completion.AutocompleteNone)
+
+	pidsLimitFlagName := "pids-limit"
+	flags.Int64(pidsLimitFlagName, 0, "Tune pod pids limit (set -1 for unlimited)")
+	_ = updateCommand.RegisterFlagCompletionFunc(pidsLimitFlagName, completion.AutocompleteNone)
+
+	restartFlagName := "restart"
+	flags.StringVar(&updateRestart, restartFlagName, "", `Restart policy to apply when containers of the pod exit ("always"|"no"|"never"|"on-failure"|"unless-stopped")`)
+	_ = updateCommand.RegisterFlagCompletionFunc(restartFlagName, common.AutocompleteRestartOption)
+
+	labelFlagName := "label"
+	flags.StringArrayVarP(&updateLabels, labelFlagName, "l", nil, "Add or change labels of the pod")
+	_ = updateCommand.RegisterFlagCompletionFunc(labelFlagName, completion.AutocompleteNone)
+
+	unsetLabelFlagName := "unsetlabel"
+	flags.StringArrayVar(&updateUnsetLabels, unsetLabelFlagName, nil, "Remove labels from the pod")
+	_ = updateCommand.RegisterFlagCompletionFunc(unsetLabelFlagName, completion.AutocompleteNone)
+}
+
+func update(cmd *cobra.Command, args []string) error {
+	opts := &entities.PodUpdateOptions{
+		NameOrID:    args[0],
+		UnsetLabels: updateUnsetLabels,
+	}
+
+	changed := false
+	for _, name := range resourceFlags {
+		changed = changed || cmd.Flags().Changed(name)
+	}
+	if changed {
+		if cmd.Flags().Changed("pids-limit") {
+			pidsLimit, err := strconv.ParseInt(cmd.Flag("pids-limit").Value.String(), 10, 32)
+			if err != nil {
+				return err
+			}
+			updateResources.PIDsLimit = &pidsLimit
+		}
+		// use a specgen since this is the easiest way to hold resource info
+		s := &specgen.SpecGenerator{}
+		s.ResourceLimits = &specs.LinuxResources{}
+		resources, err := specgenutil.GetResources(s, &updateResources)
+		if err != nil {
+			return err
+		}
+		opts.Resources = resources
+	}
+
+	if cmd.Flags().Changed("restart") {
+		policy, retries, err := util.ParseRestartPolicy(updateRestart)
+		if err != nil {
+			return err
+		}
+		opts.RestartPolicy = &policy
+		if policy == define.RestartPolicyOnFailure {
+			opts.RestartRetries = &retries
+		}
+		changed = true
+	}
+
+	if len(updateLabels) > 0 {
+		labels, err := parse.GetAllLabels(nil, updateLabels)
+		if err != nil {
+			return fmt.Errorf("unable to process labels: %w", err)
+		}
+		opts.Labels = labels
+	}
+
+	if !changed && len(opts.Labels) == 0 && len(opts.UnsetLabels) == 0 {
+		return errors.New("no changes specified, use --help to list the options")
+	}
+
+	id, err := registry.ContainerEngine().PodUpdate(registry.Context(), opts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(id)
+	return nil
+}
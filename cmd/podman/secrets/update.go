@@ -0,0 +1,95 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	"github.com/spf13/cobra"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update [options] SECRET FILE|-",
+	Short: "Update the data of a secret",
+	Long:  "Update the data of a secret and propagate it to the containers using the secret. Input can be a path to a file or \"-\" (read from stdin).",
+	RunE:  update,
+	Args:  cobra.ExactArgs(2),
+	Example: `podman secret update mysecret /path/to/secret
+		printf "secretdata" | podman secret update --signal SIGHUP mysecret -`,
+	ValidArgsFunction: common.AutocompleteSecretUpdate,
+}
+
+var (
+	updateOpts = entities.SecretUpdateOptions{}
+	updateEnv  = false
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: updateCmd,
+		Parent:  secretCmd,
+	})
+
+	flags := updateCmd.Flags()
+
+	flags.BoolVar(&updateEnv, "env", false, "Read secret data from environment variable")
+
+	flags.BoolVar(&updateOpts.Restart, "restart", false, "Restart the running containers using the secret")
+
+	signalFlagName := "signal"
+	flags.StringVar(&updateOpts.Signal, signalFlagName, "", "Signal to send to the running containers mounting the secret")
+	_ = updateCmd.RegisterFlagCompletionFunc(signalFlagName, common.AutocompleteStopSignal)
+
+	updateCmd.MarkFlagsMutuallyExclusive("restart", signalFlagName)
+}
+
+func update(_ *cobra.Command, args []string) error {
+	name := args[0]
+	path := args[1]
+
+	var reader io.Reader
+	switch {
+	case updateEnv:
+		envValue := os.Getenv(path)
+		if envValue == "" {
+			return fmt.Errorf("cannot update secret data: environment variable %s is not set", path)
+		}
+		reader = strings.NewReader(envValue)
+	case path == "-" || path == "/dev/stdin":
+		stat, err := os.Stdin.Stat()
+		if err != nil {
+			return err
+		}
+		if (stat.Mode() & os.ModeNamedPipe) == 0 {
+			return errors.New("if `-` is used, data must be passed into stdin")
+		}
+		reader = os.Stdin
+	default:
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	report, err := registry.ContainerEngine().SecretUpdate(registry.Context(), name, reader, updateOpts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.ID)
+
+	var errs []error
+	for _, id := range slices.Sorted(maps.Keys(report.Errors)) {
+		errs = append(errs, fmt.Errorf("updating secret in container %s: %s", id, report.Errors[id]))
+	}
+	return errorhandling.JoinErrors(errs)
+}
@@ -0,0 +1,88 @@
+//go:build !remote
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"golang.org/x/term"
+)
+
+var (
+	backupDescription = `
+        podman system backup
+
+        Write the configuration of all pods, containers, volumes and networks,
+        the data of local volumes, the metadata of secrets and optionally all
+        images into a single archive, which can be restored with "podman system restore".
+`
+
+	backupCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "backup [options]",
+		Args:              validate.NoArgs,
+		Short:             "Back up the local engine state into an archive",
+		Long:              backupDescription,
+		RunE:              backup,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman system backup -o backup.tar
+  podman system backup --images -o backup.tar`,
+	}
+)
+
+var (
+	backupOptions entities.SystemBackupOptions
+	backupOutput  string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: backupCommand,
+		Parent:  systemCmd,
+	})
+
+	flags := backupCommand.Flags()
+
+	outputFlagName := "output"
+	flags.StringVarP(&backupOutput, outputFlagName, "o", "", "Write to a specified file (default: stdout, which must be redirected)")
+	_ = backupCommand.RegisterFlagCompletionFunc(outputFlagName, completion.AutocompleteDefault)
+
+	flags.BoolVar(&backupOptions.Images, "images", false, "Include all local images")
+}
+
+func backup(cmd *cobra.Command, _ []string) error {
+	if backupOutput != "" {
+		f, err := os.Create(backupOutput)
+		if err != nil {
+			return fmt.Errorf("unable to create target file path %q: %w", backupOutput, err)
+		}
+		defer f.Close()
+		backupOptions.Output = f
+	} else {
+		if cmd.Flag("output").Changed {
+			return errors.New("must provide valid path for file to write to")
+		}
+		if term.IsTerminal(int(os.Stdout.Fd())) {
+			return errors.New("cannot write to terminal, use command-line redirection or the --output flag")
+		}
+		backupOptions.Output = os.Stdout
+	}
+
+	report, err := registry.ContainerEngine().SystemBackup(registry.Context(), backupOptions)
+	if err != nil {
+		if backupOutput != "" {
+			_ = os.Remove(backupOutput)
+		}
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Backed up %d pods, %d containers, %d volumes, %d networks, %d secrets (metadata only) and %d images\n",
+		len(report.Pods), len(report.Containers), len(report.Volumes), len(report.Networks), len(report.Secrets), len(report.Images))
+	return nil
+}
@@ -228,7 +228,63 @@ func printVerbose(cmd *cobra.Command, reports *entities.SystemDfReport) error {
 	if err != nil {
 		return err
 	}
-	return writeTemplate(rpt, hdrs, dfVolumes)
+	if err := writeTemplate(rpt, hdrs, dfVolumes); err != nil {
+		return err
+	}
+
+	fmt.Fprint(rpt.Writer(), "\nShared layers space usage:\n\n")
+	// only list the layers which are shared by images
+	dfLayers := make([]*dfLayer, 0, len(reports.Layers))
+	for _, d := range reports.Layers {
+		if len(d.Images) > 1 {
+			dfLayers = append(dfLayers, &dfLayer{SystemDfLayerReport: d})
+		}
+	}
+	hdrs = report.Headers(entities.SystemDfLayerReport{}, map[string]string{
+		"LayerID": "LAYER ID",
+	})
+	layerRow := "{{range .}}{{.LayerID}}\t{{.Size}}\t{{.Images}}\n{{end -}}"
+	rpt, err = rpt.Parse(report.OriginPodman, layerRow)
+	if err != nil {
+		return err
+	}
+	if err := writeTemplate(rpt, hdrs, dfLayers); err != nil {
+		return err
+	}
+
+	fmt.Fprint(rpt.Writer(), "\nArtifacts space usage:\n\n")
+	dfArtifacts := make([]*dfArtifact, 0, len(reports.Artifacts))
+	for _, d := range reports.Artifacts {
+		dfArtifacts = append(dfArtifacts, &dfArtifact{SystemDfArtifactReport: d})
+	}
+	hdrs = report.Headers(entities.SystemDfArtifactReport{}, map[string]string{
+		"UniqueSize": "UNIQUE SIZE",
+	})
+	artifactRow := "{{range .}}{{.Name}}\t{{.Digest}}\t{{.Size}}\t{{.UniqueSize}}\n{{end -}}"
+	rpt, err = rpt.Parse(report.OriginPodman, artifactRow)
+	if err != nil {
+		return err
+	}
+	if err := writeTemplate(rpt, hdrs, dfArtifacts); err != nil {
+		return err
+	}
+
+	fmt.Fprint(rpt.Writer(), "\nBuild cache space usage:\n\n")
+	var dfBuildCaches []*dfBuildCache
+	if reports.BuildCache != nil {
+		dfBuildCaches = append(dfBuildCaches, &dfBuildCache{SystemDfBuildCacheReport: reports.BuildCache})
+	}
+	hdrs = report.Headers(entities.SystemDfBuildCacheReport{}, map[string]string{
+		"IntermediateImages": "INTERMEDIATE IMAGES",
+		"IntermediateSize":   "INTERMEDIATE SIZE",
+		"CacheMountsSize":    "CACHE MOUNTS SIZE",
+	})
+	buildCacheRow := "{{range .}}{{.IntermediateImages}}\t{{.IntermediateSize}}\t{{.CacheMountsSize}}\n{{end -}}"
+	rpt, err = rpt.Parse(report.OriginPodman, buildCacheRow)
+	if err != nil {
+		return err
+	}
+	return writeTemplate(rpt, hdrs, dfBuildCaches)
 }
 
 func writeTemplate(rpt *report.Formatter, hdrs []map[string]string, output any) error {
@@ -299,6 +355,55 @@ func (d *dfVolume) Size() string {
 	return units.HumanSize(float64(d.SystemDfVolumeReport.Size))
 }
 
+type dfLayer struct {
+	*entities.SystemDfLayerReport
+}
+
+func (d *dfLayer) LayerID() string {
+	return d.SystemDfLayerReport.LayerID[0:12]
+}
+
+func (d *dfLayer) Size() string {
+	return units.HumanSize(float64(d.SystemDfLayerReport.Size))
+}
+
+func (d *dfLayer) Images() string {
+	ids := make([]string, 0, len(d.SystemDfLayerReport.Images))
+	for _, id := range d.SystemDfLayerReport.Images {
+		ids = append(ids, id[0:12])
+	}
+	return strings.Join(ids, ",")
+}
+
+type dfArtifact struct {
+	*entities.SystemDfArtifactReport
+}
+
+func (d *dfArtifact) Digest() string {
+	_, encoded, _ := strings.Cut(d.SystemDfArtifactReport.Digest, ":")
+	return encoded[0:12]
+}
+
+func (d *dfArtifact) Size() string {
+	return units.HumanSize(float64(d.SystemDfArtifactReport.Size))
+}
+
+func (d *dfArtifact) UniqueSize() string {
+	return units.HumanSize(float64(d.SystemDfArtifactReport.UniqueSize))
+}
+
+type dfBuildCache struct {
+	*entities.SystemDfBuildCacheReport
+}
+
+func (d *dfBuildCache) IntermediateSize() string {
+	return units.HumanSize(float64(d.SystemDfBuildCacheReport.IntermediateSize))
+}
+
+func (d *dfBuildCache) CacheMountsSize() string {
+	return units.HumanSize(float64(d.SystemDfBuildCacheReport.CacheMountsSize))
+}
+
 type dfSummary struct {
 	Type           string
 	Total          int
@@ -0,0 +1,69 @@
+//go:build !remote
+
+package system
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+)
+
+var (
+	restoreDescription = `
+        podman system restore
+
+        Recreate pods, containers, volumes, networks and images from an archive
+        written by "podman system backup".
+`
+
+	restoreCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "restore [options]",
+		Args:              validate.NoArgs,
+		Short:             "Restore the local engine state from an archive",
+		Long:              restoreDescription,
+		RunE:              restore,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman system restore -i backup.tar
+  cat backup.tar | podman system restore`,
+	}
+)
+
+var restoreInput string
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: restoreCommand,
+		Parent:  systemCmd,
+	})
+
+	flags := restoreCommand.Flags()
+
+	inputFlagName := "input"
+	flags.StringVarP(&restoreInput, inputFlagName, "i", "", "Read from a specified file (default: stdin)")
+	_ = restoreCommand.RegisterFlagCompletionFunc(inputFlagName, completion.AutocompleteDefault)
+}
+
+func restore(_ *cobra.Command, _ []string) error {
+	options := entities.SystemRestoreOptions{Input: os.Stdin}
+	if restoreInput != "" {
+		f, err := os.Open(restoreInput)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		options.Input = f
+	}
+
+	report, err := registry.ContainerEngine().SystemRestore(registry.Context(), options)
+	if report != nil {
+		fmt.Printf("Restored %d pods, %d containers, %d volumes, %d networks and %d images\n",
+			len(report.Pods), len(report.Containers), len(report.Volumes), len(report.Networks), len(report.Images))
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+package volumes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloneDescription = `Create a new volume with a copy of the contents of a volume.
+
+  Files are cloned with reflinks if the filesystem supports them, and copied otherwise. By default volumes used by a running container are not cloned. To clone them anyway, use the --force flag.`
+	cloneCommand = &cobra.Command{
+		Use:               "clone VOLUME NAME",
+		Short:             "Clone a volume",
+		Long:              cloneDescription,
+		RunE:              clone,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteVolumes,
+		Example:           `podman volume clone myvol myvol-copy`,
+	}
+	cloneOpts = entities.VolumeCloneOptions{}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: cloneCommand,
+		Parent:  volumeCmd,
+	})
+	flags := cloneCommand.Flags()
+	flags.BoolVarP(&cloneOpts.Force, "force", "f", false, "Clone the volume even if it is being used by a running container")
+}
+
+func clone(_ *cobra.Command, args []string) error {
+	cloneOpts.Name = args[1]
+	response, err := registry.ContainerEngine().VolumeClone(context.Background(), args[0], cloneOpts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(response.IDOrName)
+	return nil
+}
@@ -0,0 +1,47 @@
+package volumes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rollbackDescription = `Replace the contents of a volume with the contents of one of its snapshots, by default the most recent one.
+
+  The volume must not be in use by a running container.`
+	rollbackCommand = &cobra.Command{
+		Use:               "rollback VOLUME [SNAPSHOT]",
+		Short:             "Roll a volume back to a snapshot",
+		Long:              rollbackDescription,
+		RunE:              rollback,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: common.AutocompleteVolumes,
+		Example: `podman volume rollback myvol
+  podman volume rollback myvol myvol-clean`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: rollbackCommand,
+		Parent:  volumeCmd,
+	})
+}
+
+func rollback(_ *cobra.Command, args []string) error {
+	rollbackOpts := entities.VolumeRollbackOptions{}
+	if len(args) > 1 {
+		rollbackOpts.Snapshot = args[1]
+	}
+	response, err := registry.ContainerEngine().VolumeRollback(context.Background(), args[0], rollbackOpts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(response.IDOrName)
+	return nil
+}
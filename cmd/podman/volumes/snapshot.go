@@ -0,0 +1,49 @@
+package volumes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotDescription = `Create a new volume with a point-in-time copy of the contents of a volume, which the volume can be rolled back to with podman volume rollback.
+
+  Files are cloned with reflinks if the filesystem supports them, and copied otherwise. By default volumes used by a running container are not snapshotted. To snapshot them anyway, use the --force flag.`
+	snapshotCommand = &cobra.Command{
+		Use:               "snapshot VOLUME [SNAPSHOT]",
+		Short:             "Take a snapshot of a volume",
+		Long:              snapshotDescription,
+		RunE:              snapshot,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: common.AutocompleteVolumes,
+		Example: `podman volume snapshot myvol
+  podman volume snapshot myvol myvol-clean`,
+	}
+	snapshotOpts = entities.VolumeSnapshotOptions{}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotCommand,
+		Parent:  volumeCmd,
+	})
+	flags := snapshotCommand.Flags()
+	flags.BoolVarP(&snapshotOpts.Force, "force", "f", false, "Take the snapshot even if the volume is being used by a running container")
+}
+
+func snapshot(_ *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		snapshotOpts.Name = args[1]
+	}
+	response, err := registry.ContainerEngine().VolumeSnapshot(context.Background(), args[0], snapshotOpts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(response.IDOrName)
+	return nil
+}
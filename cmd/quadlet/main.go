@@ -529,7 +529,7 @@ func process() bool {
 	unitsInfoMap := generateUnitsInfoMap(units)
 
 	for _, unit := range units {
-		var service *parser.UnitFile
+		var service, timer *parser.UnitFile
 		var warnings, err error
 
 		warnIfUnsupportedServiceKeys(unit)
@@ -538,6 +538,9 @@ func process() bool {
 		case strings.HasSuffix(unit.Filename, ".container"):
 			warnIfAmbiguousName(unit, quadlet.ContainerGroup)
 			service, warnings, err = quadlet.ConvertContainer(unit, unitsInfoMap, isUserFlag)
+			if err == nil {
+				timer, err = quadlet.ConvertContainerTimer(unit, service)
+			}
 		case strings.HasSuffix(unit.Filename, ".volume"):
 			warnIfAmbiguousName(unit, quadlet.VolumeGroup)
 			service, warnings, err = quadlet.ConvertVolume(unit, unitsInfoMap, isUserFlag)
@@ -569,21 +572,28 @@ func process() bool {
 			continue
 		}
 
-		service.Path = path.Join(outputPath, service.Filename)
+		generated := []*parser.UnitFile{service}
+		if timer != nil {
+			generated = append(generated, timer)
+		}
+
+		for _, service := range generated {
+			service.Path = path.Join(outputPath, service.Filename)
 
-		if dryRunFlag {
-			data, err := service.ToString()
-			if err != nil {
-				reportError(fmt.Errorf("parsing %s: %w", service.Path, err))
+			if dryRunFlag {
+				data, err := service.ToString()
+				if err != nil {
+					reportError(fmt.Errorf("parsing %s: %w", service.Path, err))
+					continue
+				}
+				fmt.Printf("---%s---\n%s\n", service.Path, data)
 				continue
 			}
-			fmt.Printf("---%s---\n%s\n", service.Path, data)
-			continue
-		}
-		if err := generateServiceFile(service); err != nil {
-			reportError(fmt.Errorf("generating service file %s: %w", service.Path, err))
+			if err := generateServiceFile(service); err != nil {
+				reportError(fmt.Errorf("generating service file %s: %w", service.Path, err))
+			}
+			enableServiceFile(outputPath, service)
 		}
-		enableServiceFile(outputPath, service)
 	}
 	return processErred
 }
@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -181,6 +182,9 @@ type ContainerState struct {
 	// To read this field use container.getNetworkStatus() instead, this will
 	// take care of migrating the old DEPRECATED network status to the new format.
 	NetworkStatus map[string]types.StatusBlock `json:"networkStatus,omitempty"`
+	// EgressRules are the rules of the egress allow list which are active
+	// in the network namespace of the container.
+	EgressRules []define.EgressRule `json:"egressRules,omitempty"`
 	// BindMounts contains files that will be bind-mounted into the
 	// container when it is mounted.
 	// These include /etc/hosts and /etc/resolv.conf
@@ -549,6 +553,12 @@ func (c *Container) Dependencies() []string {
 	return depends
 }
 
+// HealthyDependencies gets the dependency containers which must be healthy
+// before this container is started
+func (c *Container) HealthyDependencies() []string {
+	return slices.Clone(c.config.HealthyDependencies)
+}
+
 // NewNetNS returns whether the container will create a new network namespace
 func (c *Container) NewNetNS() bool {
 	return c.config.CreateNetNS
@@ -1386,6 +1396,12 @@ func (c *Container) Secrets() []*ContainerSecret {
 	return c.config.Secrets
 }
 
+// EnvSecrets returns the secrets added to the container as environment
+// variables, keyed by the name of the variable.
+func (c *Container) EnvSecrets() map[string]*secrets.Secret {
+	return c.config.EnvSecrets
+}
+
 // Networks gets all the networks this container is connected to.
 // Please do NOT use ctr.config.Networks, as this can be changed from those
 // values at runtime via network connect and disconnect.
@@ -16,6 +16,7 @@ import (
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/sirupsen/logrus"
 	"go.podman.io/common/pkg/resize"
+	"go.podman.io/common/pkg/secrets"
 	"go.podman.io/storage/pkg/archive"
 	"golang.org/x/sys/unix"
 )
@@ -90,17 +91,40 @@ func (c *Container) Start(ctx context.Context, recursive bool) error {
 	// This prevents running `podman start` at the same time a
 	// `podman pod stop` is running, which could lead to weird races.
 	// Pod locks come before container locks, so do this first.
+	var pod *Pod
 	if c.config.Pod != "" {
 		// If we get an error, the pod was probably removed.
 		// So we get an expected ErrCtrRemoved instead of ErrPodRemoved,
 		// just ignore this and move on to syncing the container.
-		pod, _ := c.runtime.state.Pod(c.config.Pod)
-		if pod != nil {
-			pod.lock.Lock()
-			defer pod.lock.Unlock()
+		pod, _ = c.runtime.state.Pod(c.config.Pod)
+	}
+
+	// Wait for the dependencies which must be healthy before locking the
+	// pod, waiting may take a while and must not block e.g. `podman pod
+	// stop` meanwhile.  Only starting the dependencies requires the lock.
+	if len(c.config.HealthyDependencies) > 0 {
+		if recursive {
+			if pod != nil {
+				pod.lock.Lock()
+			}
+			err := c.startDependencies(ctx)
+			if pod != nil {
+				pod.lock.Unlock()
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if err := c.waitForDependenciesBeforeStart(ctx, false); err != nil {
+			return err
 		}
 	}
 
+	if pod != nil {
+		pod.lock.Lock()
+		defer pod.lock.Unlock()
+	}
+
 	return c.startNoPodLock(ctx, recursive)
 }
 
@@ -167,6 +191,12 @@ func (c *Container) Update(updateOptions *entities.ContainerUpdateOptions) error
 // ordering of the two such that no output from the container is lost (e.g. the
 // Attach call occurs before Start).
 func (c *Container) Attach(ctx context.Context, streams *define.AttachStreams, keys string, resize <-chan resize.TerminalSize, start bool) (retChan <-chan error, finalErr error) {
+	if start {
+		if err := c.waitForDependenciesBeforeStart(ctx, true); err != nil {
+			return nil, err
+		}
+	}
+
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -324,6 +354,50 @@ func (c *Container) StopWithTimeout(timeout uint) (finalErr error) {
 	return c.stop(timeout)
 }
 
+// UpdateSecret replaces the secret with the same name in the container's
+// configuration by the given secret, which has been updated in the secrets
+// manager, and rewrites the container's copy of the secret data.  The copy is
+// rewritten in place, so running containers see the new data of mounted
+// secrets immediately.  Secrets set as environment variables are updated once
+// the container is restarted.
+func (c *Container) UpdateSecret(secret *secrets.Secret) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	if c.ensureState(define.ContainerStateRemoving) {
+		return fmt.Errorf("container %s is being removed, cannot update secret: %w", c.ID(), define.ErrCtrStateInvalid)
+	}
+
+	found := false
+	for _, secr := range c.config.Secrets {
+		if secr.Name != secret.Name {
+			continue
+		}
+		found = true
+		secr.Secret = secret
+		if err := c.extractSecretToCtrStorage(secr); err != nil {
+			return fmt.Errorf("updating secret %s of container %s: %w", secret.Name, c.ID(), err)
+		}
+	}
+	for env, secr := range c.config.EnvSecrets {
+		if secr.Name == secret.Name {
+			found = true
+			c.config.EnvSecrets[env] = secret
+		}
+	}
+	if !found {
+		return fmt.Errorf("container %s does not use secret %s: %w", c.ID(), secret.Name, define.ErrInvalidArg)
+	}
+
+	return c.runtime.state.RewriteContainerConfig(c, c.config)
+}
+
 // Kill sends a signal to a container
 func (c *Container) Kill(signal uint) error {
 	if !c.batched {
@@ -995,11 +1069,18 @@ type ContainerCheckpointOptions struct {
 	IgnoreVolumes bool
 	// Pre Checkpoint container and leave container running
 	PreCheckPoint bool
-	// Dump container with Pre Checkpoint images
+	// Dump container with Pre Checkpoint images. Combined with
+	// PreCheckPoint, the new pre-checkpoint only contains the memory
+	// changed since the previous one.
 	WithPrevious bool
 	// ImportPrevious tells the API to restore container with two
 	// images. One is TargetFile, the other is ImportPrevious.
 	ImportPrevious string
+	// ImportPreviousChain are the archives of further chained
+	// pre-checkpoints, each created with WithPrevious on top of the
+	// previous one. They are imported in the given order after
+	// ImportPrevious.
+	ImportPreviousChain []string
 	// CreateImage tells Podman to create an OCI image from container
 	// checkpoint in the local image store.
 	CreateImage string
@@ -81,6 +81,13 @@ type ContainerConfig struct {
 	// Dependencies are the IDs of dependency containers.
 	// These containers must be started before this container is started.
 	Dependencies []string
+	// HealthyDependencies are the IDs of dependency containers which must
+	// report a healthy status, not merely be running, before this container
+	// is started. All of them are also listed in Dependencies.
+	HealthyDependencies []string `json:"healthyDependencies,omitempty"`
+	// HealthyDependencyTimeout is the maximum time to wait for all
+	// HealthyDependencies to become healthy.
+	HealthyDependencyTimeout time.Duration `json:"healthyDependencyTimeout,omitempty"`
 
 	// rewrite is an internal bool to indicate that the config was modified after
 	// a read from the db, e.g. to migrate config fields after an upgrade.
@@ -312,6 +319,10 @@ type ContainerNetworkConfig struct {
 	NetMode namespaces.NetworkMode `json:"networkMode,omitempty"`
 	// NetworkOptions are additional options for each network
 	NetworkOptions map[string][]string `json:"network_options,omitempty"`
+	// EgressAllow is the egress allow list of the container.  If set, the
+	// container may only send traffic to the given hosts and networks,
+	// optionally restricted to a port.
+	EgressAllow []string `json:"egressAllow,omitempty"`
 }
 
 // ContainerImageConfig is an embedded sub-config providing image configuration
@@ -371,6 +382,15 @@ type ContainerMiscConfig struct {
 	LogTag string `json:"logTag"`
 	// LogSize is the maximum size of the container's log file
 	LogSize int64 `json:"logSize"`
+	// LogMaxFiles is the number of rotated log files kept for the
+	// container.  If set, the container's log file is rotated instead
+	// of being truncated once it reaches LogSize.
+	LogMaxFiles uint `json:"logMaxFiles,omitempty"`
+	// LogMaxAge is the age of the oldest line in the container's log file
+	// at which the log file is rotated.
+	LogMaxAge time.Duration `json:"logMaxAge,omitempty"`
+	// LogCompress indicates whether rotated log files are compressed.
+	LogCompress bool `json:"logCompress,omitempty"`
 	// LogDriver driver for logs
 	LogDriver string `json:"logDriver"`
 	// File containing the conmon PID
@@ -411,6 +431,9 @@ type ContainerMiscConfig struct {
 	HealthCheckConfig *manifest.Schema2HealthConfig `json:"healthcheck"`
 	// HealthCheckOnFailureAction defines an action to take once the container turns unhealthy.
 	HealthCheckOnFailureAction define.HealthCheckOnFailureAction `json:"healthcheck_on_failure_action"`
+	// HealthCheckOnFailureHook is the command or http(s) URL notified by
+	// the notify on-failure action once the container turns unhealthy.
+	HealthCheckOnFailureHook string `json:"healthcheck_on_failure_hook,omitempty"`
 	// HealthLogDestination defines the destination where the log is stored
 	// Nil value means the default value (local).
 	HealthLogDestination *string `json:"healthLogDestination,omitempty"`
@@ -171,6 +171,11 @@ func (c *Container) verifyExecConfig(config *ExecConfig) error {
 	if config.ExitCommandDelay > 0 && len(config.ExitCommand) == 0 {
 		return fmt.Errorf("must provide a non-empty exit command if giving an exit command delay: %w", define.ErrInvalidArg)
 	}
+	// A privileged exec session could remove the rules of the egress
+	// allow list
+	if config.Privileged && len(c.config.EgressAllow) > 0 {
+		return fmt.Errorf("cannot create privileged exec sessions in a container with an egress allow list: %w", define.ErrInvalidArg)
+	}
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/libpod/lock"
 	"github.com/containers/podman/v6/pkg/parallel"
 	"github.com/containers/podman/v6/pkg/syncmap"
 	"github.com/sirupsen/logrus"
@@ -199,7 +200,9 @@ func detectCycles(graph *ContainerGraph) (bool, error) {
 
 // Visit a node on a container graph and start the container, or set an error if
 // a dependency failed to start. if restart is true, startNode will restart the node instead of starting it.
-func startNode(ctx context.Context, node *containerNode, setError bool, ctrErrors map[string]error, ctrsVisited map[string]bool, restart bool) {
+// If podLock is not nil, it is held by the caller and released while waiting
+// for dependencies to become healthy, so the pod is not blocked meanwhile.
+func startNode(ctx context.Context, node *containerNode, setError bool, ctrErrors map[string]error, ctrsVisited map[string]bool, restart bool, podLock lock.Locker) {
 	// First, check if we have already visited the node
 	if ctrsVisited[node.id] {
 		return
@@ -214,7 +217,7 @@ func startNode(ctx context.Context, node *containerNode, setError bool, ctrError
 
 		// Hit anyone who depends on us, and set errors on them too
 		for _, successor := range node.dependedOn {
-			startNode(ctx, successor, true, ctrErrors, ctrsVisited, restart)
+			startNode(ctx, successor, true, ctrErrors, ctrsVisited, restart, podLock)
 		}
 
 		return
@@ -254,6 +257,22 @@ func startNode(ctx context.Context, node *containerNode, setError bool, ctrError
 		ctrErrored = true
 	}
 
+	// Wait for the dependencies which must be healthy, if any.
+	// Must happen before we lock the container, waiting may take a while.
+	if !ctrErrored && len(node.container.config.HealthyDependencies) > 0 {
+		if podLock != nil {
+			podLock.Unlock()
+		}
+		err := node.container.waitForHealthyDependencies(ctx)
+		if podLock != nil {
+			podLock.Lock()
+		}
+		if err != nil {
+			ctrErrors[node.id] = err
+			ctrErrored = true
+		}
+	}
+
 	// Lock before we start
 	node.container.lock.Lock()
 
@@ -285,7 +304,7 @@ func startNode(ctx context.Context, node *containerNode, setError bool, ctrError
 
 	// Recurse to anyone who depends on us and start them
 	for _, successor := range node.dependedOn {
-		startNode(ctx, successor, ctrErrored, ctrErrors, ctrsVisited, restart)
+		startNode(ctx, successor, ctrErrored, ctrErrors, ctrsVisited, restart, podLock)
 	}
 }
 
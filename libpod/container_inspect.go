@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"strconv"
 	"strings"
 
 	"github.com/containers/podman/v6/libpod/define"
@@ -167,6 +168,7 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 		GraphDriver:             driverData,
 		Mounts:                  inspectMounts,
 		Dependencies:            c.Dependencies(),
+		HealthyDependencies:     c.HealthyDependencies(),
 		IsInfra:                 c.IsInfra(),
 		IsService:               c.IsService(),
 		KubeExitCodePropagation: config.KubeExitCodePropagation.String(),
@@ -430,6 +432,7 @@ func (c *Container) generateInspectContainerConfig(spec *spec.Spec) *define.Insp
 	ctrConfig.Healthcheck = c.config.HealthCheckConfig
 
 	ctrConfig.HealthcheckOnFailureAction = c.config.HealthCheckOnFailureAction.String()
+	ctrConfig.HealthcheckOnFailureHook = c.config.HealthCheckOnFailureHook
 
 	ctrConfig.HealthLogDestination = c.HealthCheckLogDestination()
 
@@ -495,6 +498,15 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 	logConfig.Path = c.config.LogPath
 	logConfig.Size = units.HumanSize(float64(c.LogSizeMax()))
 	logConfig.Tag = c.config.LogTag
+	if c.config.LogMaxFiles > 0 {
+		logConfig.Config = map[string]string{
+			"max-file": strconv.FormatUint(uint64(c.config.LogMaxFiles), 10),
+			"compress": strconv.FormatBool(c.config.LogCompress),
+		}
+		if c.config.LogMaxAge > 0 {
+			logConfig.Config["max-age"] = c.config.LogMaxAge.String()
+		}
+	}
 
 	hostConfig.LogConfig = logConfig
 
@@ -529,6 +541,7 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 	hostConfig.GroupAdd = append(hostConfig.GroupAdd, c.config.Groups...)
 
 	hostConfig.HostsFile = c.config.BaseHostsFile
+	hostConfig.EgressAllow = c.config.EgressAllow
 
 	if ctrSpec.Process != nil {
 		if ctrSpec.Process.OOMScoreAdj != nil {
@@ -63,6 +63,9 @@ const (
 	artifactsDir      = "artifacts"
 	execDirPermission = 0o755
 	preCheckpointDir  = "pre-checkpoint"
+	// name of the directory holding the previous pre-checkpoint-images
+	// while a chained pre-checkpoint is dumped
+	preCheckpointParentDir = "pre-checkpoint-parent"
 )
 
 // rootFsSize gets the size of the container, which can be divided notionally
@@ -913,7 +916,7 @@ func (c *Container) startDependencies(ctx context.Context) error {
 
 	// Traverse the graph beginning at nodes with no dependencies
 	for _, node := range graph.noDepNodes {
-		startNode(ctx, node, false, ctrErrors, ctrsVisited, false)
+		startNode(ctx, node, false, ctrErrors, ctrsVisited, false, nil)
 	}
 
 	if len(ctrErrors) > 0 {
@@ -1014,7 +1017,11 @@ func (c *Container) completeNetworkSetup() error {
 		return err
 	}
 
-	return c.addResolvConf()
+	if err := c.addResolvConf(); err != nil {
+		return err
+	}
+
+	return c.reloadEgressAllow()
 }
 
 // Initialize a container, creating it in the runtime
@@ -1025,6 +1032,11 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 		return err
 	}
 
+	// Rotate the log file before conmon opens it again, if it is due.
+	if _, err := c.rotateLog(); err != nil {
+		return err
+	}
+
 	// Generate the OCI newSpec
 	newSpec, cleanupFunc, err := c.generateSpec(ctx)
 	if err != nil {
@@ -1252,6 +1264,10 @@ func (c *Container) initAndStart(ctx context.Context) (retErr error) {
 // Please note that this DOES take the container lock.
 // Intended to be used in pod-related functions.
 func (c *Container) startNoPodLock(ctx context.Context, recursive bool) (finalErr error) {
+	if err := c.waitForDependenciesBeforeStart(ctx, recursive); err != nil {
+		return err
+	}
+
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -1322,6 +1338,10 @@ func (c *Container) start() error {
 		}
 	}
 
+	if err := c.createLogIngestTimer(); err != nil {
+		return fmt.Errorf("create log timer: %w", err)
+	}
+
 	c.newContainerEvent(events.Start)
 
 	return c.save()
@@ -1603,6 +1623,40 @@ func (c *Container) unpause() error {
 	return c.save()
 }
 
+// killPaused kills the paused container with SIGKILL and thaws it, so its
+// processes do not run any further before they exit.  Once the container is
+// locked, it is thawed on every path, even if it cannot be killed.
+func (c *Container) killPaused() (retErr error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	if c.state.State != define.ContainerStatePaused {
+		return fmt.Errorf("%q is not paused: %w", c.ID(), define.ErrCtrStateInvalid)
+	}
+
+	// The signal is queued while the container is frozen and delivered as
+	// soon as it is thawed.
+	if err := c.ociRuntime.KillContainer(c, uint(unix.SIGKILL), false); err != nil {
+		if unpauseErr := c.unpause(); unpauseErr != nil {
+			logrus.Errorf("Unpausing container %s: %v", c.ID(), unpauseErr)
+		}
+		return err
+	}
+	c.state.StoppedByUser = true
+	c.newContainerEvent(events.Kill)
+
+	if err := c.unpause(); err != nil {
+		return err
+	}
+	return c.waitForConmonToExitAndSave()
+}
+
 // Internal, non-locking function to restart a container
 // It requires to run on the same thread that holds the lock.
 func (c *Container) restartWithTimeout(ctx context.Context, timeout uint) (retErr error) {
@@ -1880,6 +1934,16 @@ func (c *Container) mountNamedVolume(v *ContainerNamedVolume, mountpoint string)
 	}
 	vol.lock.Lock()
 	defer vol.lock.Unlock()
+	if err := vol.update(); err != nil {
+		return nil, err
+	}
+	rollingBack, err := vol.rollingBack()
+	if err != nil {
+		return nil, err
+	}
+	if rollingBack {
+		return nil, fmt.Errorf("volume %s is being rolled back to a snapshot: %w", vol.Name(), define.ErrVolumeBeingUsed)
+	}
 	if vol.needsMount() {
 		if err := vol.mount(); err != nil {
 			return nil, fmt.Errorf("mounting volume %s for container %s: %w", vol.Name(), c.ID(), err)
@@ -2161,6 +2225,16 @@ func (c *Container) cleanup(ctx context.Context) error {
 		}
 	}
 
+	// Ingest the remaining lines of a json-file log, conmon is gone
+	if c.LogDriver() == define.JSONLogging {
+		if err := c.removeLogIngestTimer(ctx); err != nil {
+			logrus.Errorf("Removing log timer for container %s: %v", c.ID(), err)
+		}
+		if _, err := c.rotateLog(); err != nil {
+			logrus.Errorf("Ingesting log of container %s: %v", c.ID(), err)
+		}
+	}
+
 	// Clean up network namespace, if present
 	if err := c.cleanupNetwork(); err != nil {
 		lastError = fmt.Errorf("removing container %s network: %w", c.ID(), err)
@@ -2607,6 +2681,38 @@ func (c *Container) canWithPrevious() error {
 	return fileutils.Exists(c.PreCheckPointPath())
 }
 
+// preparePreCheckpoint prepares the pre-checkpoint directory for a new
+// pre-dump. Unless the pre-dump is chained to the previous one, the
+// previous pre-checkpoint-images are removed, otherwise they are moved
+// aside to become the parent of the new ones.
+func (c *Container) preparePreCheckpoint(withPrevious bool) error {
+	if !withPrevious {
+		return os.RemoveAll(c.PreCheckPointPath())
+	}
+	return os.Rename(c.PreCheckPointPath(), filepath.Join(c.bundlePath(), preCheckpointParentDir))
+}
+
+// finishPreCheckpoint nests the previous pre-checkpoint-images into the
+// directory of a chained pre-dump, so the whole chain is found below the
+// pre-checkpoint directory. If the pre-dump failed, the previous
+// pre-checkpoint-images are moved back in place.
+func (c *Container) finishPreCheckpoint(failed bool) error {
+	parentPath := filepath.Join(c.bundlePath(), preCheckpointParentDir)
+	if failed {
+		if err := os.RemoveAll(c.PreCheckPointPath()); err != nil {
+			return err
+		}
+		return os.Rename(parentPath, c.PreCheckPointPath())
+	}
+	// CRIU links the parent images with a symbolic link, which is
+	// replaced by the parent images themselves.
+	link := filepath.Join(c.PreCheckPointPath(), "parent")
+	if err := os.Remove(link); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return os.Rename(parentPath, link)
+}
+
 // prepareCheckpointExport writes the config and spec to
 // JSON files for later export
 func (c *Container) prepareCheckpointExport() error {
@@ -2942,6 +3048,7 @@ func (c *Container) updateHealthCheck(newHealthCheckConfig IHealthCheckConfig, c
 
 func (c *Container) updateGlobalHealthCheckConfiguration(globalOptions define.GlobalHealthCheckOptions) error {
 	oldHealthCheckOnFailureAction := c.config.HealthCheckOnFailureAction
+	oldHealthCheckOnFailureHook := c.config.HealthCheckOnFailureHook
 	oldHealthLogDestination := c.config.HealthLogDestination
 	oldHealthMaxLogCount := c.config.HealthMaxLogCount
 	oldHealthMaxLogSize := c.config.HealthMaxLogSize
@@ -2950,6 +3057,16 @@ func (c *Container) updateGlobalHealthCheckConfiguration(globalOptions define.Gl
 		c.config.HealthCheckOnFailureAction = *globalOptions.HealthCheckOnFailureAction
 	}
 
+	if globalOptions.HealthCheckOnFailureHook != nil {
+		c.config.HealthCheckOnFailureHook = *globalOptions.HealthCheckOnFailureHook
+	}
+
+	if err := c.validateHealthCheckOnFailureHook(); err != nil {
+		c.config.HealthCheckOnFailureAction = oldHealthCheckOnFailureAction
+		c.config.HealthCheckOnFailureHook = oldHealthCheckOnFailureHook
+		return err
+	}
+
 	if globalOptions.HealthMaxLogCount != nil {
 		c.config.HealthMaxLogCount = globalOptions.HealthMaxLogCount
 	}
@@ -2969,6 +3086,7 @@ func (c *Container) updateGlobalHealthCheckConfiguration(globalOptions define.Gl
 	if err := c.runtime.state.RewriteContainerConfig(c, c.config); err != nil {
 		// Assume DB write failed, revert to old resources block
 		c.config.HealthCheckOnFailureAction = oldHealthCheckOnFailureAction
+		c.config.HealthCheckOnFailureHook = oldHealthCheckOnFailureHook
 		c.config.HealthLogDestination = oldHealthLogDestination
 		c.config.HealthMaxLogCount = oldHealthMaxLogCount
 		c.config.HealthMaxLogSize = oldHealthMaxLogSize
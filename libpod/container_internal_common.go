@@ -29,6 +29,7 @@ import (
 	butil "github.com/containers/buildah/util"
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
+	"github.com/containers/podman/v6/libpod/logs"
 	"github.com/containers/podman/v6/pkg/annotations"
 	"github.com/containers/podman/v6/pkg/checkpoint/crutils"
 	"github.com/containers/podman/v6/pkg/criu"
@@ -1162,6 +1163,9 @@ func (c *Container) exportCheckpoint(options ContainerCheckpointOptions) error {
 		c.LogDriver() == define.JSONLogging {
 		includeFiles = append(includeFiles, "ctr.log")
 	}
+	if c.LogDriver() == define.JSONLogging {
+		includeFiles = append(includeFiles, filepath.Base(logs.CaptureLogPath("ctr.log")), filepath.Base(logs.IngestedOffsetPath("ctr.log")))
+	}
 	if options.PreCheckPoint {
 		includeFiles = append(includeFiles, preCheckpointDir)
 	} else {
@@ -1283,7 +1287,9 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 		return nil, 0, err
 	}
 
-	if c.state.State != define.ContainerStateRunning {
+	// Paused containers can only be checkpointed if they are left paused,
+	// as done when checkpointing all containers of a pod at once.
+	if c.state.State != define.ContainerStateRunning && (c.state.State != define.ContainerStatePaused || !options.KeepRunning) {
 		return nil, 0, fmt.Errorf("%q is not running, cannot checkpoint: %w", c.state.State, define.ErrCtrStateInvalid)
 	}
 
@@ -1303,7 +1309,19 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 	c.state.CheckpointLog = path.Join(c.bundlePath(), "dump.log")
 	c.state.CheckpointPath = c.CheckpointPath()
 
+	if options.PreCheckPoint {
+		if err := c.preparePreCheckpoint(options.WithPrevious); err != nil {
+			return nil, 0, fmt.Errorf("preparing pre-checkpoint directory: %w", err)
+		}
+	}
+
 	runtimeCheckpointDuration, err := c.ociRuntime.CheckpointContainer(c, options)
+	if options.PreCheckPoint && options.WithPrevious {
+		failed := err != nil
+		if err := c.finishPreCheckpoint(failed); err != nil {
+			return nil, 0, fmt.Errorf("nesting previous pre-checkpoint: %w", err)
+		}
+	}
 	if err != nil {
 		return nil, 0, err
 	}
@@ -1520,8 +1538,12 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 		return nil, 0, fmt.Errorf("container %s is running or paused, cannot restore: %w", c.ID(), define.ErrCtrStateInvalid)
 	}
 
+	var importPrevious []string
 	if options.ImportPrevious != "" {
-		if err := c.importPreCheckpoint(options.ImportPrevious); err != nil {
+		importPrevious = append(importPrevious, options.ImportPrevious)
+	}
+	for _, input := range append(importPrevious, options.ImportPreviousChain...) {
+		if err := c.importPreCheckpoint(input); err != nil {
 			return nil, 0, err
 		}
 	}
@@ -1856,6 +1878,10 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 
 	logrus.Debugf("Restored container %s", c.ID())
 
+	if err := c.createLogIngestTimer(); err != nil {
+		return nil, 0, fmt.Errorf("create log timer: %w", err)
+	}
+
 	c.state.State = define.ContainerStateRunning
 	c.state.Checkpointed = false
 	c.state.Restored = true
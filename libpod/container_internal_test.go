@@ -206,6 +206,53 @@ func TestPostDeleteHooks(t *testing.T) {
 	assert.Equal(t, strings.TrimSuffix(string(content), "\n"), dir)
 }
 
+func TestPreCheckpointChain(t *testing.T) {
+	dir := t.TempDir()
+	c := Container{
+		runtime: &Runtime{},
+		config: &ContainerConfig{
+			ContainerRootFSConfig: ContainerRootFSConfig{
+				StaticDir: dir,
+			},
+		},
+	}
+	preDump := func(name string) {
+		err := os.MkdirAll(c.PreCheckPointPath(), 0o700)
+		assert.NoError(t, err)
+		err = os.WriteFile(filepath.Join(c.PreCheckPointPath(), name), nil, 0o600)
+		assert.NoError(t, err)
+	}
+
+	// A pre-dump without previous ones replaces the pre-checkpoint-images.
+	preDump("stale")
+	assert.NoError(t, c.preparePreCheckpoint(false))
+	assert.NoDirExists(t, c.PreCheckPointPath())
+	preDump("first")
+
+	// CRIU links the images of a chained pre-dump to the previous ones,
+	// which are nested into them afterwards.
+	for _, name := range []string{"second", "third"} {
+		assert.NoError(t, c.preparePreCheckpoint(true))
+		preDump(name)
+		err := os.Symlink(filepath.Join("..", preCheckpointParentDir), filepath.Join(c.PreCheckPointPath(), "parent"))
+		assert.NoError(t, err)
+		assert.NoError(t, c.finishPreCheckpoint(false))
+	}
+	assert.FileExists(t, filepath.Join(c.PreCheckPointPath(), "third"))
+	assert.FileExists(t, filepath.Join(c.PreCheckPointPath(), "parent", "second"))
+	assert.FileExists(t, filepath.Join(c.PreCheckPointPath(), "parent", "parent", "first"))
+	assert.NoDirExists(t, filepath.Join(dir, preCheckpointParentDir))
+
+	// A failed chained pre-dump leaves the previous images in place.
+	assert.NoError(t, c.preparePreCheckpoint(true))
+	preDump("failed")
+	assert.NoError(t, c.finishPreCheckpoint(true))
+	assert.NoFileExists(t, filepath.Join(c.PreCheckPointPath(), "failed"))
+	assert.FileExists(t, filepath.Join(c.PreCheckPointPath(), "third"))
+	assert.FileExists(t, filepath.Join(c.PreCheckPointPath(), "parent", "parent", "first"))
+	assert.NoDirExists(t, filepath.Join(dir, preCheckpointParentDir))
+}
+
 func init() {
 	if runtime.GOOS != "windows" {
 		hookPath = "/bin/sh"
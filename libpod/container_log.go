@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"os"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/nxadm/tail"
 	"github.com/nxadm/tail/watch"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
 )
 
 // logDrivers stores the currently available log drivers, do not modify
@@ -47,11 +49,7 @@ func (c *Container) ReadLog(ctx context.Context, options *logs.LogOptions, logCh
 		return fmt.Errorf("this container is using the 'none' log driver, cannot read logs: %w", define.ErrNoLogs)
 	case define.JournaldLogging:
 		return c.readFromJournal(ctx, options, logChannel, colorID, "")
-	case define.JSONLogging:
-		// TODO provide a separate implementation of this when Conmon
-		// has support.
-		fallthrough
-	case define.KubernetesLogging, "":
+	case define.KubernetesLogging, define.JSONLogging, "":
 		return c.readFromLogFile(ctx, options, logChannel, colorID)
 	default:
 		return fmt.Errorf("unrecognized log driver %q, cannot read logs: %w", c.LogDriver(), define.ErrInternal)
@@ -59,7 +57,22 @@ func (c *Container) ReadLog(ctx context.Context, options *logs.LogOptions, logCh
 }
 
 func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOptions, logChannel chan *logs.LogLine, colorID int64) error {
-	t, tailLog, err := logs.GetLogFile(c.LogPath(), options)
+	var (
+		t           *tail.Tail
+		tailLog     []*logs.LogLine
+		storedLines iter.Seq2[*logs.LogLine, error]
+		err         error
+	)
+	if c.LogDriver() == define.JSONLogging {
+		t, tailLog, storedLines, err = c.getJSONLogFile(options)
+	} else {
+		t, tailLog, err = logs.GetLogFile(c.LogPath(), options)
+		// Without a tail, the lines of the rotated log files are
+		// streamed before the log file is followed.
+		if options.Tail < 0 {
+			storedLines = logs.RotatedLogLines(c.LogPath(), options)
+		}
+	}
 	if err != nil {
 		// If the log file does not exist, this is not fatal.
 		if errors.Is(err, os.ErrNotExist) {
@@ -78,17 +91,35 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 	}()
 
 	go func() {
+		if storedLines != nil {
+			for nll, err := range storedLines {
+				if err != nil {
+					logrus.Errorf("Reading log files of %s: %v", c.ID(), err)
+					break
+				}
+				if ctx.Err() != nil {
+					break
+				}
+				nll.CID = c.ID()
+				nll.CName = c.Name()
+				nll.ColorID = colorID
+				logChannel <- nll
+			}
+		}
 		for _, nll := range tailLog {
 			nll.CID = c.ID()
 			nll.CName = c.Name()
 			nll.ColorID = colorID
-			if nll.Since(options.Since) && nll.Until(options.Until) {
+			if nll.Since(options.Since) && nll.Until(options.Until) && nll.Matches(options) {
 				logChannel <- nll
 			}
 		}
 		defer options.WaitGroup.Done()
 		var line *tail.Line
 		var ok bool
+		// When filtering, partial lines are matched along with their
+		// full line.
+		var joiner logs.PartialJoiner
 		for {
 			select {
 			case <-ctx.Done():
@@ -106,10 +137,15 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 				logrus.Errorf("Getting new log line: %v", err)
 				continue
 			}
+			if options.Filtering() {
+				if nll = joiner.Join(nll); nll == nil {
+					continue
+				}
+			}
 			nll.CID = c.ID()
 			nll.CName = c.Name()
 			nll.ColorID = colorID
-			if nll.Since(options.Since) && nll.Until(options.Until) {
+			if nll.Since(options.Since) && nll.Until(options.Until) && nll.Matches(options) {
 				logChannel <- nll
 			}
 		}
@@ -153,3 +189,113 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 	}
 	return nil
 }
+
+// getJSONLogFile returns the tail of the log of a container using the
+// json-file log driver, its tail lines and, without a tail, its stored lines.
+// The container is locked meanwhile, so the log is not ingested concurrently.
+func (c *Container) getJSONLogFile(options *logs.LogOptions) (*tail.Tail, []*logs.LogLine, iter.Seq2[*logs.LogLine, error], error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+	}
+	t, tailLog, err := logs.GetJSONLogFile(c.LogPath(), options)
+	if err != nil || options.Tail >= 0 {
+		return t, tailLog, nil, err
+	}
+	storedLines, err := logs.StoredJSONLogLines(c.LogPath(), options)
+	if err != nil {
+		if stopErr := t.Stop(); stopErr != nil {
+			logrus.Errorf("Stopping logger: %v", stopErr)
+		}
+		return nil, nil, nil, err
+	}
+	return t, tailLog, storedLines, nil
+}
+
+// RotateLog rotates the log file of the container if log rotation is enabled
+// and the log file reached its maximum size or age.  It returns whether the
+// log file was rotated.
+func (c *Container) RotateLog() (bool, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return false, err
+		}
+	}
+	return c.rotateLog()
+}
+
+func (c *Container) rotateLog() (bool, error) {
+	rotated := false
+	switch c.LogDriver() {
+	case define.JSONLogging:
+		var err error
+		if rotated, err = c.ingestJSONLog(); err != nil {
+			return false, err
+		}
+	case define.KubernetesLogging, "":
+	default:
+		return false, nil
+	}
+	if c.config.LogMaxFiles == 0 {
+		return rotated, nil
+	}
+	rotate, err := logs.NeedsRotation(c.LogPath(), c.LogSizeMax(), c.config.LogMaxAge)
+	if err != nil || !rotate {
+		return rotated, err
+	}
+	if err := logs.RotateLogFile(c.LogPath(), int(c.config.LogMaxFiles), c.config.LogCompress); err != nil {
+		return false, fmt.Errorf("rotating log file of container %s: %w", c.ID(), err)
+	}
+
+	// Conmon keeps writing to the rotated log file until it is told to
+	// reopen the log file.  The log of the json-file log driver is only
+	// written by Podman.
+	if c.LogDriver() != define.JSONLogging {
+		if err := c.reopenLogFile(); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// ingestJSONLog ingests the lines conmon captured into the log of a
+// container using the json-file log driver, which is rotated or truncated
+// once it reaches its maximum size.  While conmon is running, the capture
+// file is moved aside afterwards, so it does not keep growing.  It returns
+// whether the log was rotated.
+func (c *Container) ingestJSONLog() (bool, error) {
+	running := c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) && c.state.ConmonPID > 0
+	limits := logs.JSONLogLimits{
+		MaxSize:  c.LogSizeMax(),
+		MaxFiles: int(c.config.LogMaxFiles),
+		Compress: c.config.LogCompress,
+	}
+	rotated, err := logs.IngestJSONLog(c.LogPath(), limits, !running)
+	if err != nil {
+		return rotated, fmt.Errorf("ingesting log file of container %s: %w", c.ID(), err)
+	}
+	if !running {
+		return rotated, nil
+	}
+	captureRotated, err := logs.RotateCaptureLog(c.LogPath())
+	if err != nil {
+		return rotated, fmt.Errorf("rotating capture log file of container %s: %w", c.ID(), err)
+	}
+	if !captureRotated {
+		return rotated, nil
+	}
+	return rotated, c.reopenLogFile()
+}
+
+// reopenLogFile tells conmon to reopen the log file of the container.
+func (c *Container) reopenLogFile() error {
+	if c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) && c.state.ConmonPID > 0 {
+		if err := unix.Kill(c.state.ConmonPID, unix.SIGUSR1); err != nil {
+			return fmt.Errorf("signaling conmon of container %s to reopen the log file: %w", c.ID(), err)
+		}
+	}
+	return nil
+}
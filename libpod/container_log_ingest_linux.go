@@ -0,0 +1,117 @@
+//go:build !remote && linux && systemd
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/systemd"
+	"github.com/sirupsen/logrus"
+	systemdCommon "go.podman.io/common/pkg/systemd"
+)
+
+// logIngestInterval is the interval at which the log of a container using
+// the json-file log driver is ingested while the container runs.
+const logIngestInterval = "5s"
+
+// logIngestTimerEnabled returns whether the log of the container is ingested
+// by a systemd timer while the container runs.
+func (c *Container) logIngestTimerEnabled() bool {
+	return c.LogDriver() == define.JSONLogging && systemdCommon.RunsOnSystemd()
+}
+
+// logIngestUnitName is the name of the systemd units ingesting the log of
+// the container.
+func (c *Container) logIngestUnitName() string {
+	return c.ID() + "-logs"
+}
+
+// createLogIngestTimer creates a systemd timer which ingests the log of a
+// container using the json-file log driver while the container runs, so
+// the capture file written by conmon does not grow and the log is kept
+// within its limits.
+func (c *Container) createLogIngestTimer() error {
+	if !c.logIngestTimerEnabled() {
+		return nil
+	}
+	// A timer of a previous run may be left if podman was killed.
+	if err := c.removeLogIngestTimer(context.Background()); err != nil {
+		return err
+	}
+
+	podman, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to get path for podman for a log timer: %w", err)
+	}
+
+	cmd := []string{"--property", "LogLevelMax=notice"}
+	if rootless.IsRootless() {
+		cmd = append(cmd, "--user")
+	}
+	path := os.Getenv("PATH")
+	if path != "" {
+		cmd = append(cmd, "--setenv=PATH="+path)
+	}
+
+	// StartLimitIntervalSec=0 so we don't hit the restart limit
+	cmd = append(cmd, "--unit", c.logIngestUnitName(), "--on-active="+logIngestInterval, "--on-unit-inactive="+logIngestInterval, "--timer-property=AccuracySec=1s", "--property=StartLimitIntervalSec=0", podman)
+
+	if logrus.IsLevelEnabled(logrus.DebugLevel) {
+		cmd = append(cmd, "--log-level=debug", "--syslog")
+	}
+
+	cmd = append(cmd, "container", "rotate-logs", c.ID())
+
+	logrus.Debugf("creating systemd-transient files: %s %s", "systemd-run", cmd)
+	systemdRun := exec.Command("systemd-run", cmd...)
+	if output, err := systemdRun.CombinedOutput(); err != nil {
+		exitError := &exec.ExitError{}
+		if errors.As(err, &exitError) {
+			return fmt.Errorf("systemd-run failed: %w: output: %s", err, strings.TrimSpace(string(output)))
+		}
+		return fmt.Errorf("failed to execute systemd-run: %w", err)
+	}
+	return nil
+}
+
+// removeLogIngestTimer removes the systemd timer and unit ingesting the log
+// of the container.
+func (c *Container) removeLogIngestTimer(ctx context.Context) error {
+	if !c.logIngestTimerEnabled() {
+		return nil
+	}
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection to remove log timer: %w", err)
+	}
+	defer conn.Close()
+
+	stopErrors := []error{}
+	// Stop the timer before the service to make sure the timer does not
+	// fire after the service is stopped.
+	for _, unit := range []string{c.logIngestUnitName() + ".timer", c.logIngestUnitName() + ".service"} {
+		stopChan := make(chan string)
+		if _, err := conn.StopUnitContext(ctx, unit, "ignore-dependencies", stopChan); err != nil {
+			if !strings.HasSuffix(err.Error(), " not loaded.") {
+				stopErrors = append(stopErrors, fmt.Errorf("removing log unit %q: %w", unit, err))
+			}
+			continue
+		}
+		if err := systemdOpSuccessful(stopChan); err != nil {
+			stopErrors = append(stopErrors, fmt.Errorf("stopping systemd log unit %q: %w", unit, err))
+		}
+	}
+	// Failed transient services are kept by systemd until they are reset.
+	if err := conn.ResetFailedUnitContext(ctx, c.logIngestUnitName()+".service"); err != nil {
+		logrus.Debugf("Failed to reset unit file: %q", err)
+	}
+	return errorhandling.JoinErrors(stopErrors)
+}
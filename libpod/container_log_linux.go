@@ -35,7 +35,7 @@ func (c *Container) readFromJournal(ctx context.Context, options *logs.LogOption
 ) error {
 	// We need the container's events in the same journal to guarantee
 	// consistency, see #10323.
-	if options.Follow && c.runtime.config.Engine.EventsLogger != "journald" {
+	if options.Follow && events.StoreType(c.runtime.config.Engine.EventsLogger) != events.Journald {
 		return fmt.Errorf("using --follow with the journald --log-driver but without the journald --events-backend (%s) is not supported", c.runtime.config.Engine.EventsLogger)
 	}
 
@@ -133,6 +133,9 @@ func (c *Container) readFromJournal(ctx context.Context, options *logs.LogOption
 		}()
 
 		tailQueue := []*logs.LogLine{} // needed for options.Tail
+		// When filtering, partial lines are matched along with their
+		// full line.
+		var joiner logs.PartialJoiner
 		doTail := options.Tail >= 0
 		doTailFunc := func() {
 			// Flush *once* we hit the end of the journal.
@@ -193,6 +196,14 @@ func (c *Container) readFromJournal(ctx context.Context, options *logs.LogOption
 				logrus.Errorf("Failed parse journal entry: %v", err)
 				return
 			}
+			if options.Filtering() {
+				if logLine = joiner.Join(logLine); logLine == nil {
+					continue
+				}
+			}
+			if !logLine.Matches(options) {
+				continue
+			}
 			id := c.ID()
 			if len(id) > 12 {
 				id = id[:12]
@@ -13,3 +13,21 @@ import (
 func (c *Container) readFromJournal(_ context.Context, _ *logs.LogOptions, _ chan *logs.LogLine, _ int64, _ string) error {
 	return fmt.Errorf("journald logging only enabled with systemd on linux: %w", define.ErrOSNotSupported)
 }
+
+// logIngestTimerEnabled returns whether the log of the container is ingested
+// by a systemd timer while the container runs.
+func (c *Container) logIngestTimerEnabled() bool {
+	return false
+}
+
+// createLogIngestTimer creates a systemd timer which ingests the log of a
+// container using the json-file log driver while the container runs.
+func (c *Container) createLogIngestTimer() error {
+	return nil
+}
+
+// removeLogIngestTimer removes the systemd timer and unit ingesting the log
+// of the container.
+func (c *Container) removeLogIngestTimer(_ context.Context) error {
+	return nil
+}
@@ -4,6 +4,7 @@ package libpod
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/containers/podman/v6/libpod/define"
@@ -138,15 +139,26 @@ func (c *Container) validate() error {
 		return fmt.Errorf("cannot set on-failure action to %s without a health check", c.config.HealthCheckOnFailureAction.String())
 	}
 
+	if c.config.HealthCheckOnFailureHook != "" && c.config.HealthCheckOnFailureAction != define.HealthCheckOnFailureActionNotify {
+		return fmt.Errorf("cannot set an on-failure hook with on-failure action %s: %w", c.config.HealthCheckOnFailureAction.String(), define.ErrInvalidArg)
+	}
+
+	if err := c.validateHealthCheckOnFailureHook(); err != nil {
+		return err
+	}
+
 	if value, exists := c.config.Labels[define.AutoUpdateLabel]; exists {
 		// TODO: we cannot reference pkg/autoupdate here due to
 		// circular dependencies.  It's worth considering moving the
 		// auto-update logic into the libpod package.
-		if value == "registry" || value == "image" {
+		if value == "registry" || value == "image" || value == "semver" {
 			if err := validateAutoUpdateImageReference(c.config.RawImageName); err != nil {
 				return err
 			}
 		}
+		if value == "semver" && c.config.Labels[define.AutoUpdateSemverLabel] == "" {
+			return fmt.Errorf("auto-update policy semver requires the %s label to be set to a version constraint: %w", define.AutoUpdateSemverLabel, define.ErrInvalidArg)
+		}
 	}
 
 	// Autoremoving image requires autoremoving the associated container
@@ -173,6 +185,33 @@ func (c *Container) validate() error {
 		}
 	}
 
+	// The QoS of the networks must be valid before it is applied
+	for name, opts := range c.config.Networks {
+		if _, err := define.ParseNetworkQoS(opts.Options); err != nil {
+			return fmt.Errorf("invalid options for network %s: %v: %w", name, err, define.ErrInvalidArg)
+		}
+	}
+
+	// The egress allow list is enforced in the network namespace created
+	// for the container
+	if len(c.config.EgressAllow) > 0 && (!c.config.CreateNetNS || (!c.config.NetMode.IsBridge() && !c.config.NetMode.IsPasta())) {
+		return fmt.Errorf("an egress allow list requires bridge or pasta networking: %w", define.ErrInvalidArg)
+	}
+	// A container which may administer its network namespace could simply
+	// remove the rules
+	if len(c.config.EgressAllow) > 0 && (c.config.Privileged || c.hasBoundingCapability("CAP_NET_ADMIN")) {
+		return fmt.Errorf("an egress allow list cannot be enforced for a container with the CAP_NET_ADMIN capability: %w", define.ErrInvalidArg)
+	}
+
+	// Only log files can be rotated
+	if c.config.LogMaxFiles > 0 {
+		switch c.config.LogDriver {
+		case define.KubernetesLogging, define.JSONLogging, "":
+		default:
+			return fmt.Errorf("log rotation is not supported by the %s log driver: %w", c.config.LogDriver, define.ErrInvalidArg)
+		}
+	}
+
 	if c.config.IsDefaultInfra && !c.config.IsInfra {
 		return fmt.Errorf("default rootfs-based infra container is set for non-infra container")
 	}
@@ -197,3 +236,12 @@ func validateAutoUpdateImageReference(imageName string) error {
 	}
 	return nil
 }
+
+// hasBoundingCapability returns true if the capability is in the bounding set
+// of the container process.
+func (c *Container) hasBoundingCapability(capability string) bool {
+	if c.config.Spec == nil || c.config.Spec.Process == nil || c.config.Spec.Process.Capabilities == nil {
+		return false
+	}
+	return slices.Contains(c.config.Spec.Process.Capabilities.Bounding, capability)
+}
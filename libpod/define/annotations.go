@@ -162,6 +162,17 @@ const (
 	// IDs optionally with colon separated mount options.
 	VolumesFromAnnotation = "io.podman.annotations.volumes-from"
 
+	// RequiresHealthyAnnotation is used by kube play when playing a kube
+	// yaml to specify containers which must be healthy before the
+	// container is started.
+	// It is expected to be a comma-separated list of container names.
+	RequiresHealthyAnnotation = "io.podman.annotations.requires-healthy"
+
+	// RequiresHealthyTimeoutAnnotation is used by kube play when playing a
+	// kube yaml to specify the maximum time to wait for the containers
+	// listed in RequiresHealthyAnnotation to become healthy.
+	RequiresHealthyTimeoutAnnotation = "io.podman.annotations.requires-healthy-timeout"
+
 	// KubeHealthCheckAnnotation is used by kube play to tell podman that any health checks should follow
 	// the k8s behavior of waiting for the intialDelaySeconds to be over before updating the status
 	KubeHealthCheckAnnotation = "io.podman.annotations.kube.health.check"
@@ -178,6 +189,24 @@ const (
 	// MemoryNodesAnnotation is used to restrict memory allocations to specific memory nodes on NUMA systems
 	MemoryNodesAnnotation = "io.podman.annotations.memory-nodes"
 
+	// KubeIngressBandwidthAnnotation is used by kube play to limit the
+	// rate of the traffic received by the pod on its networks.  It is
+	// expected to be a quantity of bits per second, e.g. 10M.
+	KubeIngressBandwidthAnnotation = "kubernetes.io/ingress-bandwidth"
+
+	// KubeEgressBandwidthAnnotation is used by kube play to limit the
+	// rate of the traffic sent by the pod on its networks.  It is
+	// expected to be a quantity of bits per second, e.g. 10M.
+	KubeEgressBandwidthAnnotation = "kubernetes.io/egress-bandwidth"
+
+	// NetworkLatencyAnnotation is used by kube play to add latency to the
+	// traffic sent by the pod on its networks.
+	NetworkLatencyAnnotation = "io.podman.annotations.network-latency"
+
+	// NetworkLossAnnotation is used by kube play to drop a percentage of
+	// the packets sent by the pod on its networks.
+	NetworkLossAnnotation = "io.podman.annotations.network-loss"
+
 	// TotalAnnotationSizeLimitB is the max length of annotations allowed by Kubernetes.
 	TotalAnnotationSizeLimitB int = 256 * (1 << 10) // 256 kB
 )
@@ -7,3 +7,7 @@ const AutoUpdateLabel = "io.containers.autoupdate"
 // AutoUpdateAuthfileLabel denotes the container label key to specify authfile
 // in container labels.
 const AutoUpdateAuthfileLabel = "io.containers.autoupdate.authfile"
+
+// AutoUpdateSemverLabel denotes the container label key to specify the
+// semantic version constraint (e.g., "~1.4") of the semver auto-update policy.
+const AutoUpdateSemverLabel = "io.containers.autoupdate.semver"
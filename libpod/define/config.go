@@ -41,6 +41,21 @@ const VolumeDriverLocal = "local"
 // uses volumes backed by an image.
 const VolumeDriverImage = "image"
 
+// Backends enforcing the size and inodes limits of local volumes.
+const (
+	// VolumeQuotaBackendAuto selects XFS project quota if the volume path
+	// supports it and a loop device otherwise.
+	VolumeQuotaBackendAuto = "auto"
+	// VolumeQuotaBackendXFS uses XFS project quota on the volume path.
+	VolumeQuotaBackendXFS = "xfs"
+	// VolumeQuotaBackendLoop stores the volume in an ext4 image of the
+	// given size, mounted with a loop device.
+	VolumeQuotaBackendLoop = "loop"
+	// VolumeQuotaBackendTmpfs is used for tmpfs volumes, which are limited
+	// by the size option of the tmpfs mount. It cannot be requested.
+	VolumeQuotaBackendTmpfs = "tmpfs"
+)
+
 const (
 	OCIManifestDir  = "oci-dir"
 	OCIArchive      = "oci-archive"
@@ -65,6 +65,8 @@ const (
 	K8sKindDaemonSet = "daemonset"
 	// a Job kube yaml spec
 	K8sKindJob = "job"
+	// A StatefulSet kube yaml spec
+	K8sKindStatefulSet = "statefulset"
 )
 
 type WeightDevice struct {
@@ -63,6 +63,8 @@ type InspectContainerConfig struct {
 	Healthcheck *manifest.Schema2HealthConfig `json:"Healthcheck,omitempty"`
 	// HealthcheckOnFailureAction defines an action to take once the container turns unhealthy.
 	HealthcheckOnFailureAction string `json:"HealthcheckOnFailureAction,omitempty"`
+	// HealthcheckOnFailureHook is the command or URL notified by the notify on-failure action.
+	HealthcheckOnFailureHook string `json:"HealthcheckOnFailureHook,omitempty"`
 	// HealthLogDestination defines the destination where the log is stored
 	HealthLogDestination string `json:"HealthLogDestination,omitempty"`
 	// HealthMaxLogCount is maximum number of attempts in the HealthCheck log file.
@@ -443,6 +445,9 @@ type InspectContainerHostConfig struct {
 	ExtraHosts []string `json:"ExtraHosts"`
 	// HostsFile is the base file to create the `/etc/hosts` file inside the container.
 	HostsFile string `json:"HostsFile"`
+	// EgressAllow is the egress allow list of the container.
+	// This is Libpod-specific and not included in `docker inspect`.
+	EgressAllow []string `json:"EgressAllow,omitempty"`
 	// GroupAdd contains groups that the user inside the container will be
 	// added to.
 	GroupAdd []string `json:"GroupAdd"`
@@ -748,6 +753,10 @@ type InspectNetworkSettings struct {
 	// container has joined.
 	// It is a map of network name to network information.
 	Networks map[string]*InspectAdditionalNetwork `json:"Networks,omitempty"`
+	// EgressRules are the active rules of the egress allow list of the
+	// container.  Traffic to other destinations is dropped.
+	// This is Libpod-specific and not included in `docker inspect`.
+	EgressRules []EgressRule `json:"EgressRules,omitempty"`
 }
 
 // InspectContainerData provides a detailed record of a container's configuration
@@ -788,6 +797,7 @@ type InspectContainerData struct {
 	SizeRootFs              int64                       `json:"SizeRootFs,omitempty"`
 	Mounts                  []InspectMount              `json:"Mounts"`
 	Dependencies            []string                    `json:"Dependencies"`
+	HealthyDependencies     []string                    `json:"HealthyDependencies,omitempty"`
 	NetworkSettings         *InspectNetworkSettings     `json:"NetworkSettings"`
 	Namespace               string                      `json:"Namespace"`
 	IsInfra                 bool                        `json:"IsInfra"`
@@ -0,0 +1,71 @@
+package define
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// EgressRule is an active rule of the egress allow list of a container.
+type EgressRule struct {
+	// Allow is the entry of the egress allow list the rule was created
+	// from.
+	Allow string `json:"Allow"`
+	// Destinations are the networks the container may send traffic to.
+	// Host names are resolved when the network of the container is set
+	// up.
+	Destinations []string `json:"Destinations"`
+	// Port is the TCP and UDP destination port the container may send
+	// traffic to.  0 allows all ports and protocols.
+	Port uint16 `json:"Port,omitempty"`
+}
+
+// ParseEgressAllow parses an entry of an egress allow list of the form
+// HOST[:PORT], where HOST is a host name, an IP address or a network in
+// CIDR notation.  IPv6 addresses must be enclosed in brackets when a port
+// is given.
+func ParseEgressAllow(allow string) (host string, port uint16, err error) {
+	host = allow
+	if strings.HasPrefix(allow, "[") {
+		end := strings.Index(allow, "]")
+		if end < 0 {
+			return "", 0, fmt.Errorf("invalid egress allow entry %q: missing closing bracket", allow)
+		}
+		host = allow[1:end]
+		rest := allow[end+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ":") {
+				return "", 0, fmt.Errorf("invalid egress allow entry %q", allow)
+			}
+			port, err = parseEgressPort(allow, rest[1:])
+		}
+	} else if strings.Count(allow, ":") == 1 {
+		var rawPort string
+		host, rawPort, _ = strings.Cut(allow, ":")
+		port, err = parseEgressPort(allow, rawPort)
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	switch {
+	case host == "":
+		return "", 0, fmt.Errorf("invalid egress allow entry %q: missing host", allow)
+	case strings.Contains(host, "/"):
+		if _, _, err := net.ParseCIDR(host); err != nil {
+			return "", 0, fmt.Errorf("invalid egress allow entry %q: %w", allow, err)
+		}
+	case net.ParseIP(host) == nil && strings.ContainsAny(host, ": "):
+		return "", 0, fmt.Errorf("invalid egress allow entry %q: invalid host %q", allow, host)
+	}
+	return host, port, nil
+}
+
+func parseEgressPort(allow, rawPort string) (uint16, error) {
+	port, err := strconv.ParseUint(rawPort, 10, 16)
+	if err != nil || port == 0 {
+		return 0, fmt.Errorf("invalid egress allow entry %q: invalid port %q", allow, rawPort)
+	}
+	return uint16(port), nil
+}
@@ -220,4 +220,8 @@ var (
 
 	// ErrHealthCheckTimeout indicates that a HealthCheck timed out.
 	ErrHealthCheckTimeout = errors.New("healthcheck command exceeded timeout")
+
+	// ErrDependencyNotHealthy indicates that a dependency container, which
+	// is required to be healthy, did not become healthy in time.
+	ErrDependencyNotHealthy = errors.New("dependency container is not healthy")
 )
@@ -82,6 +82,9 @@ const (
 	DefaultHealthMaxLogSize uint = 500
 	// DefaultHealthCheckLocalDestination default value
 	DefaultHealthCheckLocalDestination string = "local"
+	// DefaultHealthyDependencyTimeout is the default time to wait for
+	// dependency containers to become healthy
+	DefaultHealthyDependencyTimeout = "5m"
 )
 
 const HealthCheckEventsLoggerDestination string = "events_logger"
@@ -112,6 +115,9 @@ const (
 	HealthCheckOnFailureActionRestart = iota
 	// HealthCheckOnFailureActionNonce instructs Podman to stop the container on an unhealthy status.
 	HealthCheckOnFailureActionStop = iota
+	// HealthCheckOnFailureActionNotify instructs Podman to run the
+	// container's on-failure hook on an unhealthy status.
+	HealthCheckOnFailureActionNotify = iota
 )
 
 // String representations for on-failure actions.
@@ -121,6 +127,7 @@ const (
 	strHealthCheckOnFailureActionKill    = "kill"
 	strHealthCheckOnFailureActionRestart = "restart"
 	strHealthCheckOnFailureActionStop    = "stop"
+	strHealthCheckOnFailureActionNotify  = "notify"
 )
 
 // SupportedHealthCheckOnFailureActions lists all supported healthcheck restart policies.
@@ -129,6 +136,7 @@ var SupportedHealthCheckOnFailureActions = []string{
 	strHealthCheckOnFailureActionKill,
 	strHealthCheckOnFailureActionRestart,
 	strHealthCheckOnFailureActionStop,
+	strHealthCheckOnFailureActionNotify,
 }
 
 // String returns the string representation of the HealthCheckOnFailureAction.
@@ -142,6 +150,8 @@ func (h HealthCheckOnFailureAction) String() string {
 		return strHealthCheckOnFailureActionRestart
 	case HealthCheckOnFailureActionStop:
 		return strHealthCheckOnFailureActionStop
+	case HealthCheckOnFailureActionNotify:
+		return strHealthCheckOnFailureActionNotify
 	default:
 		return strHealthCheckOnFailureActionInvalid
 	}
@@ -159,6 +169,8 @@ func ParseHealthCheckOnFailureAction(s string) (HealthCheckOnFailureAction, erro
 		return HealthCheckOnFailureActionRestart, nil
 	case strHealthCheckOnFailureActionStop:
 		return HealthCheckOnFailureActionStop, nil
+	case strHealthCheckOnFailureActionNotify:
+		return HealthCheckOnFailureActionNotify, nil
 	default:
 		err := fmt.Errorf("invalid on-failure action %q for health check: supported actions are %s", s, strings.Join(SupportedHealthCheckOnFailureActions, ","))
 		return HealthCheckOnFailureActionInvalid, err
@@ -187,6 +199,8 @@ type UpdateHealthCheckConfig struct {
 	HealthMaxLogCount *uint `json:"health_max_log_count,omitempty"`
 	// HealthOnFailure set the action to take once the container turns unhealthy.
 	HealthOnFailure *string `json:"health_on_failure,omitempty"`
+	// HealthOnFailureHook set the command or URL notified by the notify on-failure action.
+	HealthOnFailureHook *string `json:"health_on_failure_hook,omitempty"`
 	// Disable healthchecks on container.
 	NoHealthCheck *bool `json:"no_healthcheck,omitempty"`
 	// HealthCmd set a healthcheck command for the container. ('none' disables the existing healthcheck)
@@ -338,6 +352,8 @@ func (u *UpdateHealthCheckConfig) GetNewGlobalHealthCheck() (GlobalHealthCheckOp
 		globalOptions.HealthCheckOnFailureAction = &val
 	}
 
+	globalOptions.HealthCheckOnFailureHook = u.HealthOnFailureHook
+
 	return globalOptions, nil
 }
 
@@ -355,4 +371,5 @@ type GlobalHealthCheckOptions struct {
 	HealthMaxLogCount          *uint
 	HealthMaxLogSize           *uint
 	HealthCheckOnFailureAction *HealthCheckOnFailureAction
+	HealthCheckOnFailureHook   *string
 }
@@ -168,3 +168,31 @@ type CPUUsage struct {
 	SystemPercent float64 `json:"systemPercent"`
 	IdlePercent   float64 `json:"idlePercent"`
 }
+
+// HostStats is a sample of the host resource usage
+type HostStats struct {
+	// CPUUserPercent, CPUSystemPercent and CPUIdlePercent are the CPU
+	// usage of the host since the previous sample.
+	CPUUserPercent   float64 `json:"cpuUserPercent"`
+	CPUSystemPercent float64 `json:"cpuSystemPercent"`
+	CPUIdlePercent   float64 `json:"cpuIdlePercent"`
+	MemFree          int64   `json:"memFree"`
+	MemTotal         int64   `json:"memTotal"`
+	SwapFree         int64   `json:"swapFree"`
+	SwapTotal        int64   `json:"swapTotal"`
+	// CPUTimes are the cumulative CPU times at the time of the sample,
+	// the start of the interval of the next sample.
+	CPUTimes HostCPUTimes `json:"-"`
+}
+
+// HostCPUTimes are the cumulative CPU times of the host since boot, in clock
+// ticks.
+type HostCPUTimes struct {
+	User   uint64 `json:"user"`
+	System uint64 `json:"system"`
+	// Idle includes the time spent waiting for I/O.
+	Idle uint64 `json:"idle"`
+	// Total is the sum of all CPU times, including those not broken out
+	// above.
+	Total uint64 `json:"total"`
+}
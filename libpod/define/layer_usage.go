@@ -0,0 +1,11 @@
+package define
+
+// ImageLayerUsage describes the disk usage of an image layer.
+type ImageLayerUsage struct {
+	// ID of the layer.
+	ID string
+	// Size is the uncompressed size of the layer.
+	Size int64
+	// Images are the IDs of the images using the layer.
+	Images []string
+}
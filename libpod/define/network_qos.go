@@ -0,0 +1,117 @@
+package define
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Per-network options limiting the traffic of a container on a network.
+// They are stored along with the driver-specific options of the network
+// attachment and applied by Podman rather than the network backend.
+const (
+	// NetworkQoSIngressRate limits the rate of the traffic received by the
+	// container on the network.
+	NetworkQoSIngressRate = "ingress_rate"
+	// NetworkQoSEgressRate limits the rate of the traffic sent by the
+	// container on the network.
+	NetworkQoSEgressRate = "egress_rate"
+	// NetworkQoSLatency delays the traffic sent by the container on the
+	// network.
+	NetworkQoSLatency = "latency"
+	// NetworkQoSLoss drops the given percentage of the traffic sent by the
+	// container on the network.
+	NetworkQoSLoss = "loss"
+)
+
+// NetworkQoSOptions are the names of all per-network QoS options.
+var NetworkQoSOptions = []string{NetworkQoSIngressRate, NetworkQoSEgressRate, NetworkQoSLatency, NetworkQoSLoss}
+
+// NetworkQoS describes the limits of the traffic of a container on a
+// network.
+type NetworkQoS struct {
+	// IngressRate is the maximum rate of received traffic in bytes per
+	// second.  0 is unlimited.
+	IngressRate uint64
+	// EgressRate is the maximum rate of sent traffic in bytes per second.
+	// 0 is unlimited.
+	EgressRate uint64
+	// Latency is added to sent traffic.
+	Latency time.Duration
+	// Loss is the percentage of sent packets which are dropped.
+	Loss float64
+}
+
+// rateUnits maps the units of rates accepted by tc(8) to their size in bits
+// per second.
+var rateUnits = map[string]uint64{
+	"":     1,
+	"bit":  1,
+	"kbit": 1000,
+	"mbit": 1000 * 1000,
+	"gbit": 1000 * 1000 * 1000,
+	"tbit": 1000 * 1000 * 1000 * 1000,
+	"bps":  8,
+	"kbps": 8 * 1000,
+	"mbps": 8 * 1000 * 1000,
+	"gbps": 8 * 1000 * 1000 * 1000,
+	"tbps": 8 * 1000 * 1000 * 1000 * 1000,
+}
+
+// ParseNetworkRate parses a rate with a unit as accepted by tc(8), e.g.
+// 10mbit, and returns it in bytes per second.  Rates without unit are in
+// bits per second.
+func ParseNetworkRate(rate string) (uint64, error) {
+	lower := strings.ToLower(rate)
+	number := strings.TrimRight(lower, "abcdefghijklmnopqrstuvwxyz")
+	multiplier, ok := rateUnits[lower[len(number):]]
+	if !ok {
+		return 0, fmt.Errorf("invalid rate %q: unknown unit %q", rate, lower[len(number):])
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid rate %q, must be a positive number with a unit such as kbit, mbit or mbps", rate)
+	}
+	bytes := uint64(value * float64(multiplier) / 8)
+	if bytes == 0 {
+		return 0, fmt.Errorf("invalid rate %q, must be at least 1 byte per second", rate)
+	}
+	return bytes, nil
+}
+
+// ParseNetworkQoS returns the QoS set in the per-network options of a
+// network attachment, or nil if none is set.
+func ParseNetworkQoS(options map[string]string) (*NetworkQoS, error) {
+	var qos *NetworkQoS
+	for _, name := range NetworkQoSOptions {
+		value, ok := options[name]
+		if !ok {
+			continue
+		}
+		if qos == nil {
+			qos = new(NetworkQoS)
+		}
+		var err error
+		switch name {
+		case NetworkQoSIngressRate:
+			qos.IngressRate, err = ParseNetworkRate(value)
+		case NetworkQoSEgressRate:
+			qos.EgressRate, err = ParseNetworkRate(value)
+		case NetworkQoSLatency:
+			qos.Latency, err = time.ParseDuration(value)
+			if err == nil && qos.Latency <= 0 {
+				err = fmt.Errorf("invalid latency %q, must be a positive duration", value)
+			}
+		case NetworkQoSLoss:
+			qos.Loss, err = strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+			if err != nil || qos.Loss < 0 || qos.Loss > 100 {
+				err = fmt.Errorf("invalid loss %q, must be a percentage between 0 and 100", value)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("network option %s: %w", name, err)
+		}
+	}
+	return qos, nil
+}
@@ -63,6 +63,31 @@ type InspectVolumeData struct {
 	StorageID string `json:"StorageID,omitempty"`
 	// LockNumber is the number of the volume's Libpod lock.
 	LockNumber uint32
+	// SnapshotOf is the name of the volume this volume is a snapshot of.
+	SnapshotOf string `json:"SnapshotOf,omitempty"`
+	// ClonedFrom is the name of the volume this volume is a clone of.
+	ClonedFrom string `json:"ClonedFrom,omitempty"`
+	// Quota describes the size and inodes limits of the volume and their
+	// current usage. Only set for local volumes with a limit.
+	Quota *InspectVolumeQuota `json:"Quota,omitempty"`
+}
+
+// InspectVolumeQuota describes the limits of a volume and how much of them is
+// in use.
+type InspectVolumeQuota struct {
+	// Backend is the quota backend enforcing the limits: xfs, loop or
+	// tmpfs.
+	Backend string `json:"Backend"`
+	// Size is the maximum size of the volume in bytes.
+	Size uint64 `json:"Size,omitempty"`
+	// Inodes is the maximum number of inodes of the volume.
+	Inodes uint64 `json:"Inodes,omitempty"`
+	// Usage is the number of bytes used by the volume. For loop volumes
+	// which are not mounted, this is the space allocated by their image.
+	// Unmounted tmpfs volumes are empty.
+	Usage uint64 `json:"Usage"`
+	// InodeUsage is the number of inodes used by the volume.
+	InodeUsage uint64 `json:"InodeUsage,omitempty"`
 }
 
 type VolumeReload struct {
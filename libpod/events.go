@@ -33,6 +33,18 @@ func (c *Container) newContainerEvent(status events.Status) {
 	}
 }
 
+// NewRollbackEvent creates a new event for a container whose image has been
+// rolled back by auto-update.
+func (c *Container) NewRollbackEvent() {
+	c.newContainerEvent(events.Rollback)
+}
+
+// NewUpdateUnhealthyEvent creates a new event for a container which has been
+// updated by auto-update but did not become healthy, and was not rolled back.
+func (c *Container) NewUpdateUnhealthyEvent() {
+	c.newContainerEvent(events.UpdateUnhealthy)
+}
+
 // newContainerHealthCheckEvent creates a new healthcheck event with the given status
 func (c *Container) newContainerHealthCheckEvent(healthCheckResult define.HealthCheckResults) {
 	if err := c.newContainerEventWithInspectData(events.HealthStatus, healthCheckResult, false); err != nil {
@@ -67,7 +67,9 @@ type Details struct {
 // EventerOptions describe options that need to be passed to create
 // an eventer
 type EventerOptions struct {
-	// EventerType describes whether to use journald, file or memory
+	// EventerType describes whether to use journald, file or memory, or
+	// is the unix://, http:// or https:// URL of an endpoint to forward
+	// events to
 	EventerType string
 	// LogFilePath is the path to where the log file should reside if using
 	// the file logger
@@ -200,6 +202,9 @@ const (
 	Restart Status = "restart"
 	// Restore ...
 	Restore Status = "restore"
+	// Rollback indicates that auto-update rolled back a container to its
+	// previous image.
+	Rollback Status = "rollback"
 	// Rotate indicates that the log file was rotated
 	Rotate Status = "log-rotation"
 	// Save ...
@@ -220,6 +225,9 @@ const (
 	Untag Status = "untag"
 	// Update indicates that a container's configuration has been modified.
 	Update Status = "update"
+	// UpdateUnhealthy indicates that auto-update updated a container to an
+	// image which did not become healthy, and did not roll it back.
+	UpdateUnhealthy Status = "update-unhealthy"
 )
 
 // EventFilter for filtering events
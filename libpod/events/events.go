@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.podman.io/storage/pkg/stringid"
@@ -24,10 +25,46 @@ func IsValidEventer(eventer string) bool {
 	case LogFile, Journald, Null:
 		return true
 	default:
-		return false
+		return isForwardURL(eventer)
 	}
 }
 
+// isForwardURL returns whether the eventer is the URL of an endpoint to
+// forward events to, optionally prefixed by the eventer storing them (e.g.
+// journald+unix:///run/events.sock).
+func isForwardURL(eventer string) bool {
+	_, url := splitForwardEventer(eventer)
+	for _, scheme := range []string{"unix://", "http://", "https://"} {
+		if strings.HasPrefix(url, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitForwardEventer splits an eventer of the form [journald+|file+]URL
+// into the type of the eventer storing the events and the URL of the
+// endpoint to forward them to.  Without a prefix, the events are stored with
+// the default eventer.
+func splitForwardEventer(eventer string) (EventerType, string) {
+	for _, store := range []EventerType{Journald, LogFile} {
+		if rest, ok := strings.CutPrefix(eventer, store.String()+"+"); ok {
+			return store, rest
+		}
+	}
+	return DefaultEventerType, eventer
+}
+
+// StoreType returns the type of the eventer storing the events of the given
+// eventer, which differs from it if events are forwarded to an endpoint.
+func StoreType(eventer string) EventerType {
+	if isForwardURL(eventer) {
+		store, _ := splitForwardEventer(eventer)
+		return store
+	}
+	return EventerType(eventer)
+}
+
 // NewEvent creates an event struct and populates with
 // the given status and time.
 func NewEvent(status Status) Event {
@@ -198,6 +235,8 @@ func StringToStatus(name string) (Status, error) {
 		return Restart, nil
 	case Restore.String():
 		return Restore, nil
+	case Rollback.String():
+		return Rollback, nil
 	case Rotate.String():
 		return Rotate, nil
 	case Save.String():
@@ -218,6 +257,8 @@ func StringToStatus(name string) (Status, error) {
 		return Untag, nil
 	case Update.String():
 		return Update, nil
+	case UpdateUnhealthy.String():
+		return UpdateUnhealthy, nil
 	}
 	return "", fmt.Errorf("unknown event status %q", name)
 }
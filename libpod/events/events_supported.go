@@ -13,6 +13,9 @@ import (
 // NewEventer creates an eventer based on the eventer type
 func NewEventer(options EventerOptions) (Eventer, error) {
 	logrus.Debugf("Initializing event backend %s", options.EventerType)
+	if isForwardURL(options.EventerType) {
+		return newForwardEventer(options)
+	}
 	switch EventerType(strings.ToLower(options.EventerType)) {
 	case Journald:
 		return newJournalDEventer(options)
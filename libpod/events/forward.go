@@ -0,0 +1,398 @@
+//go:build linux || freebsd
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.podman.io/storage/pkg/ioutils"
+	"go.podman.io/storage/pkg/lockfile"
+)
+
+var (
+	// forwardBufferSize is the maximum number of events queued while
+	// the endpoint is not reachable.  The oldest events are dropped first.
+	forwardBufferSize = 1000
+	// forwardAttempts is the number of attempts to deliver events before
+	// backing off.
+	forwardAttempts = 3
+	// forwardRetryDelay is the delay before the second attempt.  It
+	// doubles for every further attempt.
+	forwardRetryDelay = 100 * time.Millisecond
+	// forwardMaxBackoff is the maximum time for which delivery is not
+	// attempted after failed attempts.  Events are queued in the
+	// meantime.
+	forwardMaxBackoff = 30 * time.Second
+	// forwardTimeout is the timeout of a single delivery attempt.
+	forwardTimeout = 5 * time.Second
+	// forwardWaitTimeout is the maximum time Wait waits for deliveries
+	// in progress.
+	forwardWaitTimeout = time.Second
+)
+
+const (
+	// forwardQueueFile is the name of the file events are queued in, next
+	// to the events log file.
+	forwardQueueFile = "forward.queue"
+	// forwardBackoffFile is the name of the file recording the backoff
+	// after failed deliveries, next to the queue file, so that all Podman
+	// processes back off.
+	forwardBackoffFile = "forward.backoff"
+)
+
+// forwardBackoff is the backoff after failed deliveries.
+type forwardBackoff struct {
+	// Backoff is the current backoff, doubled after every failed
+	// delivery.
+	Backoff time.Duration `json:"backoff"`
+	// RetryAfter is the time before which no delivery is attempted.
+	RetryAfter time.Time `json:"retryAfter"`
+}
+
+// EventForwarder is an eventer storing events with a journald or file
+// eventer, which events are read from, and forwarding them as JSON to a Unix
+// socket or an HTTP endpoint.  Events are queued on disk and delivered in the
+// background, so writing an event does not wait for the endpoint.  Events
+// which cannot be delivered stay queued and are delivered along with the
+// next ones, possibly by another Podman process.  After failed deliveries,
+// events are only queued until the backoff expires.
+type EventForwarder struct {
+	store  Eventer
+	url    *url.URL
+	client *http.Client
+
+	queuePath    string
+	backoffPath  string
+	queueLock    *lockfile.LockFile // Protects the queue file
+	deliveryLock *lockfile.LockFile // Held while delivering the queue and updating the backoff
+	deliveries   sync.WaitGroup
+}
+
+// newForwardEventer returns an eventer forwarding events to the URL in
+// options.EventerType.  For unix:// URLs, events are written as one JSON
+// object per line to the stream socket at the URL's path.  For http:// and
+// https:// URLs, each event is sent as the body of a POST request.
+func newForwardEventer(options EventerOptions) (Eventer, error) {
+	storeType, rawURL := splitForwardEventer(options.EventerType)
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing events endpoint: %w", err)
+	}
+	switch u.Scheme {
+	case "unix":
+		if u.Path == "" {
+			return nil, fmt.Errorf("events endpoint %q: missing socket path", rawURL)
+		}
+	case "http", "https":
+		if u.Host == "" {
+			return nil, fmt.Errorf("events endpoint %q: missing host", rawURL)
+		}
+	default:
+		return nil, fmt.Errorf("events endpoint %q: unsupported scheme %q", rawURL, u.Scheme)
+	}
+
+	storeOptions := options
+	storeOptions.EventerType = storeType.String()
+	store, err := NewEventer(storeOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(options.LogFilePath)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating events dirs: %w", err)
+	}
+	queuePath := filepath.Join(dir, forwardQueueFile)
+	queueLock, err := lockfile.GetLockFile(queuePath + ".lock")
+	if err != nil {
+		return nil, err
+	}
+	deliveryLock, err := lockfile.GetLockFile(queuePath + ".delivery.lock")
+	if err != nil {
+		return nil, err
+	}
+	return &EventForwarder{
+		store:        store,
+		url:          u,
+		client:       &http.Client{Timeout: forwardTimeout},
+		queuePath:    queuePath,
+		backoffPath:  filepath.Join(dir, forwardBackoffFile),
+		queueLock:    queueLock,
+		deliveryLock: deliveryLock,
+	}, nil
+}
+
+// Write stores the event, queues it for forwarding and, unless backing off,
+// starts delivering the queued events in the background.
+func (e *EventForwarder) Write(ee Event) error {
+	err := e.store.Write(ee)
+	if qErr := e.enqueue(ee); qErr != nil {
+		return errors.Join(err, fmt.Errorf("queueing event for %s: %w", e.url.Redacted(), qErr))
+	}
+	if e.backingOff() {
+		return err
+	}
+
+	e.deliveries.Add(1)
+	go func() {
+		defer e.deliveries.Done()
+		e.deliverQueued()
+	}()
+	return err
+}
+
+// enqueue appends the event to the queue file.
+func (e *EventForwarder) enqueue(ee Event) error {
+	b, err := json.Marshal(ee)
+	if err != nil {
+		return err
+	}
+	e.queueLock.Lock()
+	defer e.queueLock.Unlock()
+
+	f, err := os.OpenFile(e.queuePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(b, '\n'))
+	return err
+}
+
+// deliverQueued delivers the queued events unless another delivery, of this
+// or another process, is in progress, which then delivers them instead.
+func (e *EventForwarder) deliverQueued() {
+	if e.backingOff() {
+		return
+	}
+
+	for {
+		if err := e.deliveryLock.TryLock(); err != nil {
+			return
+		}
+		err := e.flush()
+		e.deliveryLock.Unlock()
+		if err != nil {
+			logrus.Debugf("Forwarding events: %v", err)
+			return
+		}
+		// Events queued after the queue was found empty but before
+		// the lock was released have not been delivered by anyone.
+		if st, err := os.Stat(e.queuePath); err != nil || st.Size() == 0 {
+			return
+		}
+	}
+}
+
+// flush delivers the queued events until the queue is empty.  It must be
+// called with the delivery lock held.
+func (e *EventForwarder) flush() error {
+	var err error
+	delay := forwardRetryDelay
+	for attempt := 1; attempt <= forwardAttempts; attempt++ {
+		var more bool
+		more, err = e.deliverQueue()
+		if err == nil {
+			if !more {
+				break
+			}
+			attempt, delay = 0, forwardRetryDelay
+			continue
+		}
+		logrus.Debugf("Forwarding events to %s (attempt %d/%d): %v", e.url.Redacted(), attempt, forwardAttempts, err)
+		if attempt < forwardAttempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+
+	if err != nil {
+		b := e.backoff()
+		b.Backoff = min(max(2*b.Backoff, time.Second), forwardMaxBackoff)
+		b.RetryAfter = time.Now().Add(b.Backoff)
+		if bErr := e.setBackoff(b); bErr != nil {
+			logrus.Errorf("Recording backoff of forwarding events: %v", bErr)
+		}
+		return fmt.Errorf("forwarding events to %s: %w", e.url.Redacted(), err)
+	}
+	return e.setBackoff(forwardBackoff{})
+}
+
+// backoff returns the backoff after failed deliveries.  A missing or invalid
+// backoff file means no backoff.
+func (e *EventForwarder) backoff() forwardBackoff {
+	var b forwardBackoff
+	data, err := os.ReadFile(e.backoffPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logrus.Debugf("Reading backoff of forwarding events: %v", err)
+		}
+		return b
+	}
+	if err := json.Unmarshal(data, &b); err != nil {
+		logrus.Debugf("Parsing backoff of forwarding events: %v", err)
+		return forwardBackoff{}
+	}
+	return b
+}
+
+// backingOff returns whether deliveries are suspended after failed ones.
+func (e *EventForwarder) backingOff() bool {
+	return time.Now().Before(e.backoff().RetryAfter)
+}
+
+// setBackoff records the backoff after failed deliveries or, if it has no
+// retry time, removes it.  It must be called with the delivery lock held, unless no
+// delivery can be in progress.
+func (e *EventForwarder) setBackoff(b forwardBackoff) error {
+	if b.RetryAfter.IsZero() {
+		if err := os.Remove(e.backoffPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(e.backoffPath, data, 0o600)
+}
+
+// deliverQueue delivers the events in the queue and removes the delivered
+// ones from it.  It returns whether events were queued.
+func (e *EventForwarder) deliverQueue() (bool, error) {
+	e.queueLock.Lock()
+	data, err := os.ReadFile(e.queuePath)
+	e.queueLock.Unlock()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	// Ignore a partially written event at the end.
+	data = data[:bytes.LastIndexByte(data, '\n')+1]
+	if len(data) == 0 {
+		return false, nil
+	}
+
+	events := bytes.SplitAfter(data, []byte("\n"))
+	events = events[:len(events)-1]
+	consumed := 0
+	if dropped := len(events) - forwardBufferSize; dropped > 0 {
+		logrus.Warnf("Dropped %d events as %s was not reachable", dropped, e.url.Redacted())
+		for _, ev := range events[:dropped] {
+			consumed += len(ev)
+		}
+		events = events[dropped:]
+	}
+
+	delivered, deliverErr := e.deliver(events)
+	consumed += delivered
+	if err := e.dequeue(consumed); err != nil {
+		return false, errors.Join(deliverErr, err)
+	}
+	return true, deliverErr
+}
+
+// dequeue removes the first n bytes of the queue.  Events are only appended
+// to the queue while delivering, so they are the delivered events.
+func (e *EventForwarder) dequeue(n int) error {
+	if n == 0 {
+		return nil
+	}
+	e.queueLock.Lock()
+	defer e.queueLock.Unlock()
+
+	data, err := os.ReadFile(e.queuePath)
+	if err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(e.queuePath, data[n:], 0o600)
+}
+
+// deliver sends the events, each a line of JSON, to the endpoint and returns
+// the number of bytes delivered.
+func (e *EventForwarder) deliver(events [][]byte) (int, error) {
+	if e.url.Scheme == "unix" {
+		return e.deliverUnix(events)
+	}
+	return e.deliverHTTP(events)
+}
+
+// deliverUnix writes the events to the socket.
+func (e *EventForwarder) deliverUnix(events [][]byte) (int, error) {
+	conn, err := net.DialTimeout("unix", e.url.Path, forwardTimeout)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(forwardTimeout)); err != nil {
+		return 0, err
+	}
+	buf := bytes.Join(events, nil)
+	if _, err := conn.Write(buf); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
+// deliverHTTP posts the events one by one to the endpoint.
+func (e *EventForwarder) deliverHTTP(events [][]byte) (int, error) {
+	delivered := 0
+	for _, ev := range events {
+		resp, err := e.client.Post(e.url.String(), "application/json", bytes.NewReader(bytes.TrimSuffix(ev, []byte("\n"))))
+		if err != nil {
+			return delivered, err
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return delivered, fmt.Errorf("unexpected response: %s", resp.Status)
+		}
+		delivered += len(ev)
+	}
+	return delivered, nil
+}
+
+// Wait waits up to forwardWaitTimeout for the deliveries in progress, such
+// that events are delivered before a short-lived process exits.  Events not
+// delivered by then stay queued.  While backing off, Wait does not wait.
+func (e *EventForwarder) Wait() {
+	if e.backingOff() {
+		return
+	}
+	done := make(chan struct{})
+	go func() {
+		e.deliveries.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(forwardWaitTimeout):
+		logrus.Debugf("Events not yet delivered to %s stay queued", e.url.Redacted())
+	}
+}
+
+// Read reads the events from the eventer storing them.
+func (e *EventForwarder) Read(ctx context.Context, options ReadOptions) error {
+	return e.store.Read(ctx, options)
+}
+
+// String returns the type of the eventer storing the events and the URL of
+// the endpoint without credentials.
+func (e *EventForwarder) String() string {
+	return e.store.String() + "+" + e.url.Redacted()
+}
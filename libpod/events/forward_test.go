@@ -0,0 +1,228 @@
+//go:build linux || freebsd
+
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestForwarder returns a forwarder storing events in a log file in a
+// temporary directory.
+func newTestForwarder(t *testing.T, url string) *EventForwarder {
+	eventer, err := NewEventer(EventerOptions{
+		EventerType: "file+" + url,
+		LogFilePath: filepath.Join(t.TempDir(), "events", "events.log"),
+	})
+	require.NoError(t, err)
+	return eventer.(*EventForwarder)
+}
+
+// queuedEvents returns the number of events in the queue of the forwarder.
+func queuedEvents(t *testing.T, forwarder *EventForwarder) int {
+	f, err := os.Open(forwarder.queuePath)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	require.NoError(t, err)
+	defer f.Close()
+	n := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		n++
+	}
+	return n
+}
+
+func TestIsValidEventerForward(t *testing.T) {
+	for _, eventer := range []string{"unix:///run/events.sock", "http://localhost:8080/events", "https://example.com", "journald+unix:///run/events.sock", "file+http://localhost:8080"} {
+		assert.True(t, IsValidEventer(eventer), eventer)
+	}
+	for _, eventer := range []string{"tcp://localhost:8080", "/run/events.sock", "syslog", "none+unix:///run/events.sock"} {
+		assert.False(t, IsValidEventer(eventer), eventer)
+	}
+
+	assert.Equal(t, Journald, StoreType("journald+unix:///run/events.sock"))
+	assert.Equal(t, LogFile, StoreType("file+unix:///run/events.sock"))
+	assert.Equal(t, DefaultEventerType, StoreType("unix:///run/events.sock"))
+	assert.Equal(t, LogFile, StoreType("file"))
+
+	_, err := NewEventer(EventerOptions{EventerType: "unix://"})
+	assert.ErrorContains(t, err, "missing socket path")
+	_, err = NewEventer(EventerOptions{EventerType: "http:///events"})
+	assert.ErrorContains(t, err, "missing host")
+}
+
+func TestForwardUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.sock")
+	forwarder := newTestForwarder(t, "unix://"+path)
+
+	// The endpoint is not listening yet, so the event stays queued
+	// without failing the write.
+	event := NewEvent(Start)
+	event.Name = "first"
+	require.NoError(t, forwarder.Write(event))
+	forwarder.deliveries.Wait()
+	assert.Equal(t, 1, queuedEvents(t, forwarder))
+	assert.True(t, forwarder.backingOff())
+
+	// Events are only queued during the backoff.
+	event.Name = "second"
+	require.NoError(t, forwarder.Write(event))
+	forwarder.deliveries.Wait()
+	assert.Equal(t, 2, queuedEvents(t, forwarder))
+
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer listener.Close()
+	received := make(chan []string)
+	go func() {
+		var names []string
+		defer func() { received <- names }()
+		for len(names) < 3 {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			scanner := bufio.NewScanner(conn)
+			for scanner.Scan() {
+				var e Event
+				if err := json.Unmarshal(scanner.Bytes(), &e); err == nil {
+					names = append(names, e.Name)
+				}
+			}
+			conn.Close()
+		}
+	}()
+
+	require.NoError(t, forwarder.setBackoff(forwardBackoff{}))
+	event.Name = "third"
+	require.NoError(t, forwarder.Write(event))
+	forwarder.Wait()
+	assert.Equal(t, []string{"first", "second", "third"}, <-received)
+	assert.Equal(t, 0, queuedEvents(t, forwarder))
+}
+
+func TestForwardBackoffShared(t *testing.T) {
+	dir := t.TempDir()
+	newForwarder := func() *EventForwarder {
+		eventer, err := NewEventer(EventerOptions{
+			EventerType: "file+unix://" + filepath.Join(dir, "events.sock"),
+			LogFilePath: filepath.Join(dir, "events", "events.log"),
+		})
+		require.NoError(t, err)
+		return eventer.(*EventForwarder)
+	}
+
+	first := newForwarder()
+	require.NoError(t, first.Write(NewEvent(Start)))
+	first.deliveries.Wait()
+	assert.True(t, first.backingOff())
+
+	// Another process sharing the queue backs off as well, so it only
+	// queues events and does not wait for their delivery.
+	second := newForwarder()
+	assert.True(t, second.backingOff())
+	require.NoError(t, second.Write(NewEvent(Start)))
+	start := time.Now()
+	second.Wait()
+	assert.Less(t, time.Since(start), forwardRetryDelay)
+	assert.Equal(t, 2, queuedEvents(t, second))
+}
+
+func TestForwardHTTP(t *testing.T) {
+	var (
+		lock     sync.Mutex
+		received []Event
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var e Event
+		require.NoError(t, json.Unmarshal(body, &e))
+		lock.Lock()
+		received = append(received, e)
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	forwarder := newTestForwarder(t, server.URL+"/events")
+	for _, status := range []Status{Create, Start} {
+		require.NoError(t, forwarder.Write(NewEvent(status)))
+		forwarder.Wait()
+	}
+	require.Len(t, received, 2)
+	assert.Equal(t, Create, received[0].Status)
+	assert.Equal(t, Start, received[1].Status)
+	assert.Equal(t, 0, queuedEvents(t, forwarder))
+}
+
+func TestForwardRead(t *testing.T) {
+	forwarder := newTestForwarder(t, "unix://"+filepath.Join(t.TempDir(), "events.sock"))
+	require.NoError(t, forwarder.setBackoff(forwardBackoff{Backoff: time.Hour, RetryAfter: time.Now().Add(time.Hour)}))
+
+	event := NewEvent(Start)
+	event.Name = "stored"
+	event.Type = Container
+	require.NoError(t, forwarder.Write(event))
+
+	// Events are read from the eventer storing them.
+	eventChannel := make(chan ReadResult)
+	go func() {
+		require.NoError(t, forwarder.Read(context.Background(), ReadOptions{EventChannel: eventChannel}))
+	}()
+	var names []string
+	for result := range eventChannel {
+		require.NoError(t, result.Error)
+		names = append(names, result.Event.Name)
+	}
+	assert.Equal(t, []string{"stored"}, names)
+}
+
+func TestForwardBufferSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.sock")
+	forwarder := newTestForwarder(t, "unix://"+path)
+	require.NoError(t, forwarder.setBackoff(forwardBackoff{Backoff: time.Hour, RetryAfter: time.Now().Add(time.Hour)}))
+
+	for range forwardBufferSize + 10 {
+		require.NoError(t, forwarder.Write(NewEvent(Start)))
+	}
+	forwarder.deliveries.Wait()
+	assert.Equal(t, forwardBufferSize+10, queuedEvents(t, forwarder))
+
+	// The oldest events are dropped on delivery.
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer listener.Close()
+	received := make(chan int)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			close(received)
+			return
+		}
+		defer conn.Close()
+		n := 0
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			n++
+		}
+		received <- n
+	}()
+	require.NoError(t, forwarder.flush())
+	assert.Equal(t, forwardBufferSize, <-received)
+	assert.Equal(t, 0, queuedEvents(t, forwarder))
+}
@@ -20,6 +20,10 @@ import (
 	"golang.org/x/sys/unix"
 )
 
+// healthyDependencyPollInterval is the interval at which the health status of
+// dependency containers is checked while waiting for them to become healthy.
+const healthyDependencyPollInterval = 500 * time.Millisecond
+
 // HealthCheck verifies the state and validity of the healthcheck configuration
 // on the container and then executes the healthcheck
 func (r *Runtime) HealthCheck(ctx context.Context, name string) (define.HealthCheckStatus, error) {
@@ -44,7 +48,7 @@ func (r *Runtime) HealthCheck(ctx context.Context, name string) (define.HealthCh
 
 	hcStatus, logStatus, err := container.runHealthCheck(ctx, isStartupHC)
 	if !isStartupHC {
-		if err := container.processHealthCheckStatus(logStatus); err != nil {
+		if err := container.processHealthCheckStatus(ctx, logStatus); err != nil {
 			return hcStatus, err
 		}
 	}
@@ -185,7 +189,7 @@ func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.
 	return hcResult, healthCheckResult.Status, hcErr
 }
 
-func (c *Container) processHealthCheckStatus(status string) error {
+func (c *Container) processHealthCheckStatus(ctx context.Context, status string) error {
 	if status != define.HealthCheckUnhealthy {
 		return nil
 	}
@@ -212,6 +216,11 @@ func (c *Container) processHealthCheckStatus(status string) error {
 			return fmt.Errorf("stopping container after health-check turned unhealthy: %w", err)
 		}
 
+	case define.HealthCheckOnFailureActionNotify:
+		if err := c.notifyHealthCheckHook(ctx); err != nil {
+			return fmt.Errorf("notifying hook after health-check turned unhealthy: %w", err)
+		}
+
 	default: // Should not happen but better be safe than sorry
 		return fmt.Errorf("unsupported on-failure action %d", c.config.HealthCheckOnFailureAction)
 	}
@@ -479,3 +488,74 @@ func (c *Container) healthCheckStatus() (string, error) {
 
 	return results.Status, nil
 }
+
+// waitForDependenciesBeforeStart waits for the dependencies of a container
+// which is about to be started to become healthy, starting them first if
+// recursive is set. Waiting may take a while, so this must be called before
+// the container is locked.
+func (c *Container) waitForDependenciesBeforeStart(ctx context.Context, recursive bool) error {
+	if len(c.config.HealthyDependencies) == 0 {
+		return nil
+	}
+	// Starting a running container fails anyway, don't make it wait.
+	state, err := c.State()
+	if err != nil {
+		return err
+	}
+	if state == define.ContainerStateRunning || state == define.ContainerStatePaused {
+		return nil
+	}
+	if recursive {
+		if err := c.startDependencies(ctx); err != nil {
+			return err
+		}
+	}
+	return c.waitForHealthyDependencies(ctx)
+}
+
+// waitForHealthyDependencies blocks until all dependencies which are required
+// to be healthy report a healthy status.  An error is returned if one of them
+// stops or does not become healthy within the configured timeout.
+// This function does not lock the container, but locks each dependency.
+func (c *Container) waitForHealthyDependencies(ctx context.Context) error {
+	if len(c.config.HealthyDependencies) == 0 {
+		return nil
+	}
+
+	timeout := c.config.HealthyDependencyTimeout
+	if timeout <= 0 {
+		timeout, _ = time.ParseDuration(define.DefaultHealthyDependencyTimeout)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, id := range c.config.HealthyDependencies {
+		dep, err := c.runtime.state.Container(id)
+		if err != nil {
+			return fmt.Errorf("retrieving dependency %s of container %s from state: %w", id, c.ID(), err)
+		}
+		logrus.Debugf("Waiting for dependency %s of container %s to become healthy", id, c.ID())
+		for {
+			status, err := dep.HealthCheckStatus()
+			if err != nil {
+				return fmt.Errorf("retrieving health status of dependency %s of container %s: %w", id, c.ID(), err)
+			}
+			if status == define.HealthCheckHealthy {
+				break
+			}
+			state, err := dep.State()
+			if err != nil {
+				return fmt.Errorf("retrieving state of dependency %s of container %s: %w", id, c.ID(), err)
+			}
+			if state != define.ContainerStateRunning && state != define.ContainerStatePaused {
+				return fmt.Errorf("dependency %s of container %s is %s: %w", id, c.ID(), state, define.ErrDependencyNotHealthy)
+			}
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("dependency %s of container %s did not become healthy within %s: %w", id, c.ID(), timeout, define.ErrDependencyNotHealthy)
+			case <-time.After(healthyDependencyPollInterval):
+			}
+		}
+	}
+	return nil
+}
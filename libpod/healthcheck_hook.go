@@ -0,0 +1,139 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/sirupsen/logrus"
+)
+
+// healthCheckHookPayload is the JSON document passed to the on-failure hook
+// of the notify action, either on stdin of a command or as the body of a POST
+// request.
+type healthCheckHookPayload struct {
+	// ID is the full ID of the container.
+	ID string `json:"ID"`
+	// Name is the name of the container.
+	Name string `json:"Name"`
+	define.HealthCheckResults
+}
+
+// isHealthCheckHookURL returns true if the hook is a webhook rather than a
+// command.
+func isHealthCheckHookURL(hook string) bool {
+	return strings.HasPrefix(hook, "http://") || strings.HasPrefix(hook, "https://")
+}
+
+// validateHealthCheckOnFailureHook makes sure that the notify action has a
+// hook to run.
+func (c *Container) validateHealthCheckOnFailureHook() error {
+	hook := c.config.HealthCheckOnFailureHook
+	if c.config.HealthCheckOnFailureAction == define.HealthCheckOnFailureActionNotify && hook == "" {
+		return fmt.Errorf("on-failure action %s requires a hook: %w", c.config.HealthCheckOnFailureAction.String(), define.ErrInvalidArg)
+	}
+	if isHealthCheckHookURL(hook) {
+		if _, err := url.ParseRequestURI(hook); err != nil {
+			return fmt.Errorf("invalid health check on-failure hook %q: %w", hook, err)
+		}
+	}
+	return nil
+}
+
+// notifyHealthCheckHook runs the on-failure hook once the container turned
+// unhealthy.  Subsequent failing runs of the healthcheck do not trigger the
+// hook again until the container was healthy in between.
+func (c *Container) notifyHealthCheckHook(ctx context.Context) error {
+	hook, timeout, results, notify, err := c.healthCheckHookState()
+	if err != nil || !notify {
+		return err
+	}
+
+	payload, err := json.Marshal(healthCheckHookPayload{
+		ID:                 c.ID(),
+		Name:               c.Name(),
+		HealthCheckResults: results,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The container is not locked while the hook runs, the hook may very
+	// well inspect the container.
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if isHealthCheckHookURL(hook) {
+		return postHealthCheckHook(ctx, hook, payload)
+	}
+
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", hook)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Env = append(os.Environ(),
+		"PODMAN_CONTAINER_ID="+c.ID(),
+		"PODMAN_CONTAINER_NAME="+c.Name(),
+		"PODMAN_HEALTH_STATUS="+results.Status,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("running health check hook %q: %w: %s", hook, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// healthCheckHookState returns the hook, its timeout and the health log of
+// the container, copied while the container is locked.  notify is false if
+// the hook was already notified about the current failing streak.
+func (c *Container) healthCheckHookState() (hook string, timeout time.Duration, results define.HealthCheckResults, notify bool, err error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+	}
+	results, err = c.readHealthCheckLog()
+	if err != nil {
+		return "", 0, results, false, err
+	}
+	retries := 1
+	if c.config.HealthCheckConfig != nil && c.config.HealthCheckConfig.Retries > 1 {
+		retries = c.config.HealthCheckConfig.Retries
+	}
+	if results.FailingStreak != retries {
+		logrus.Debugf("Container %s is still unhealthy, not notifying hook again", c.ID())
+		return "", 0, results, false, nil
+	}
+
+	timeout, err = time.ParseDuration(define.DefaultHealthCheckTimeout)
+	if err != nil {
+		return "", 0, results, false, err
+	}
+	if c.config.HealthCheckConfig != nil && c.config.HealthCheckConfig.Timeout > 0 {
+		timeout = c.config.HealthCheckConfig.Timeout
+	}
+	return c.config.HealthCheckOnFailureHook, timeout, results, true, nil
+}
+
+// postHealthCheckHook sends the payload to the webhook at hookURL.
+func postHealthCheckHook(ctx context.Context, hookURL string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting to health check hook %q: %w", hookURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("posting to health check hook %q: unexpected status %s", hookURL, resp.Status)
+	}
+	return nil
+}
@@ -78,6 +78,54 @@ func (r *Runtime) info() (*define.Info, error) {
 	return &info, nil
 }
 
+// hostStatsSampleInterval is the time between the two readings of the host
+// CPU times when there is no previous sample to compute the usage from.
+const hostStatsSampleInterval = 500 * time.Millisecond
+
+// HostStats returns a sample of the current host CPU and memory usage. The CPU
+// usage is computed over the time since the previous sample or, if previous
+// is nil, over a short sampling interval.
+func (r *Runtime) HostStats(previous *define.HostStats) (*define.HostStats, error) {
+	var start define.HostCPUTimes
+	if previous != nil {
+		start = previous.CPUTimes
+	} else {
+		first, err := getCPUTimes()
+		if err != nil {
+			return nil, err
+		}
+		start = *first
+		time.Sleep(hostStatsSampleInterval)
+	}
+	end, err := getCPUTimes()
+	if err != nil {
+		return nil, err
+	}
+	mi, err := system.ReadMemInfo()
+	if err != nil {
+		return nil, fmt.Errorf("reading memory info: %w", err)
+	}
+
+	stats := &define.HostStats{
+		MemFree:   mi.MemFree,
+		MemTotal:  mi.MemTotal,
+		SwapFree:  mi.SwapFree,
+		SwapTotal: mi.SwapTotal,
+		CPUTimes:  *end,
+	}
+	user := float64(end.User - start.User)
+	sys := float64(end.System - start.System)
+	idle := float64(end.Idle - start.Idle)
+	if total := float64(end.Total - start.Total); total > 0 {
+		stats.CPUUserPercent = math.Round(user/total*100*100) / 100
+		stats.CPUSystemPercent = math.Round(sys/total*100*100) / 100
+		stats.CPUIdlePercent = math.Round(idle/total*100*100) / 100
+	} else {
+		stats.CPUIdlePercent = 100
+	}
+	return stats, nil
+}
+
 // top-level "host" info
 func (r *Runtime) hostInfo() (*define.HostInfo, error) {
 	// let's say OS, arch, number of cpus, amount of memory, maybe os distribution/version, hostname, kernel version, uptime
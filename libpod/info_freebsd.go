@@ -40,3 +40,27 @@ func getCPUUtilization() (*define.CPUUsage, error) {
 		IdlePercent:   timeToPercent(times[unix.CP_IDLE], total),
 	}, nil
 }
+
+// getCPUTimes returns the cumulative CPU times of the host from the
+// kern.cp_time sysctl.
+func getCPUTimes() (*define.HostCPUTimes, error) {
+	buf, err := unix.SysctlRaw("kern.cp_time")
+	if err != nil {
+		return nil, fmt.Errorf("reading sysctl kern.cp_time: %w", err)
+	}
+	if len(buf) < 8*unix.CPUSTATES {
+		return nil, fmt.Errorf("unexpected size %d of sysctl kern.cp_time", len(buf))
+	}
+	var times [unix.CPUSTATES]uint64
+	var total uint64
+	for i := range times {
+		times[i] = *(*uint64)(unsafe.Pointer(&buf[8*i]))
+		total += times[i]
+	}
+	return &define.HostCPUTimes{
+		User:   times[unix.CP_USER],
+		System: times[unix.CP_SYS],
+		Idle:   times[unix.CP_IDLE],
+		Total:  total,
+	}, nil
+}
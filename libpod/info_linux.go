@@ -4,6 +4,7 @@ package libpod
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -96,24 +97,45 @@ func (r *Runtime) setPlatformHostInfo(info *define.HostInfo) error {
 	return nil
 }
 
-func statToPercent(stats []string) (*define.CPUUsage, error) {
-	userTotal, err := strconv.ParseFloat(stats[1], 64)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse user value %q: %w", stats[1], err)
+// parseCPUTimes parses the fields of the "cpu" line of /proc/stat.  The total
+// is the sum of the user, nice, system, idle, iowait, irq, softirq and steal
+// times; guest times are already accounted for in user and nice.  The time
+// spent waiting for I/O counts as idle.
+func parseCPUTimes(stats []string) (*define.HostCPUTimes, error) {
+	// Older kernels do not report all columns, but user, nice, system
+	// and idle are always present.
+	if len(stats) < 5 {
+		return nil, fmt.Errorf("unexpected format of /proc/stat: %q", strings.Join(stats, " "))
 	}
-	systemTotal, err := strconv.ParseFloat(stats[3], 64)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse system value %q: %w", stats[3], err)
+	var times [8]uint64
+	var total uint64
+	for i := 0; i < len(times) && i+1 < len(stats); i++ {
+		val, err := strconv.ParseUint(stats[i+1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse /proc/stat value %q: %w", stats[i+1], err)
+		}
+		times[i] = val
+		total += val
 	}
-	idleTotal, err := strconv.ParseFloat(stats[4], 64)
+	// column 1 is user, column 3 is system, columns 4 and 5 are idle and iowait
+	return &define.HostCPUTimes{
+		User:   times[0],
+		System: times[2],
+		Idle:   times[3] + times[4],
+		Total:  total,
+	}, nil
+}
+
+func statToPercent(stats []string) (*define.CPUUsage, error) {
+	times, err := parseCPUTimes(stats)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse idle value %q: %w", stats[4], err)
+		return nil, err
 	}
-	total := userTotal + systemTotal + idleTotal
+	total := float64(times.Total)
 	s := define.CPUUsage{
-		UserPercent:   math.Round((userTotal/total*100)*100) / 100,
-		SystemPercent: math.Round((systemTotal/total*100)*100) / 100,
-		IdlePercent:   math.Round((idleTotal/total*100)*100) / 100,
+		UserPercent:   math.Round((float64(times.User)/total*100)*100) / 100,
+		SystemPercent: math.Round((float64(times.System)/total*100)*100) / 100,
+		IdlePercent:   math.Round((float64(times.Idle)/total*100)*100) / 100,
 	}
 	return &s, nil
 }
@@ -135,3 +157,21 @@ func getCPUUtilization() (*define.CPUUsage, error) {
 	stats := strings.Fields(scanner.Text())
 	return statToPercent(stats)
 }
+
+// getCPUTimes returns the cumulative CPU times of the host from the "cpu" line
+// of /proc/stat.
+func getCPUTimes() (*define.HostCPUTimes, error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("/proc/stat is empty")
+	}
+	return parseCPUTimes(strings.Fields(scanner.Text()))
+}
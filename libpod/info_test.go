@@ -24,9 +24,9 @@ func Test_statToPercent(t *testing.T) {
 			name: "GoodParse",
 			args: args{in0: []string{"cpu", "33628064", "27537", "9696996", "1314806705", "588142", "4775073", "2789228", "0", "598711", "0"}},
 			want: &define.CPUUsage{
-				UserPercent:   2.48,
+				UserPercent:   2.46,
 				SystemPercent: 0.71,
-				IdlePercent:   96.81,
+				IdlePercent:   96.27,
 			},
 			wantErr: assert.NoError,
 		},
@@ -19,6 +19,7 @@ import (
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/env"
+	v1apps "github.com/containers/podman/v6/pkg/k8s.io/api/apps/v1"
 	v1 "github.com/containers/podman/v6/pkg/k8s.io/api/core/v1"
 	"github.com/containers/podman/v6/pkg/k8s.io/apimachinery/pkg/api/resource"
 	v12 "github.com/containers/podman/v6/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -234,6 +235,90 @@ func GenerateForKubeDeployment(_ context.Context, pod *YAMLPod, options entities
 	return &dep, nil
 }
 
+// GenerateForKubeStatefulSet returns a YAMLStatefulSet from a YAMLPod that is then used to create a kubernetes StatefulSet
+// kind YAML.  Persistent volume claims of the pod are turned into volumeClaimTemplates so that each replica gets its own volume.
+func GenerateForKubeStatefulSet(_ context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLStatefulSet, error) {
+	// Restart policy for StatefulSets can only be set to Always
+	if pod.Spec.RestartPolicy != "" && pod.Spec.RestartPolicy != v1.RestartPolicyAlways {
+		return nil, fmt.Errorf("k8s StatefulSets can only have restartPolicy set to Always")
+	}
+
+	// Create label map that will be added to podSpec and StatefulSet metadata
+	// The matching label lets the StatefulSet know which pods to manage
+	appKey := "app"
+	matchLabels := map[string]string{appKey: pod.Name}
+	// Add the key:value (app:pod-name) to the podSpec labels
+	if pod.Labels == nil {
+		pod.Labels = matchLabels
+	} else {
+		pod.Labels[appKey] = pod.Name
+	}
+
+	var claimTemplates []v1.PersistentVolumeClaim
+	podSpec := *pod.Spec
+	podSpec.Volumes = nil
+	for _, vol := range pod.Spec.Volumes {
+		if vol.PersistentVolumeClaim == nil {
+			podSpec.Volumes = append(podSpec.Volumes, vol)
+			continue
+		}
+		claimTemplates = append(claimTemplates, v1.PersistentVolumeClaim{
+			ObjectMeta: v12.ObjectMeta{
+				Name: vol.Name,
+			},
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.ResourceRequirements{
+					Requests: map[v1.ResourceName]resource.Quantity{
+						v1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+				AccessModes: []v1.PersistentVolumeAccessMode{
+					v1.ReadWriteOnce,
+				},
+			},
+		})
+	}
+
+	stsSpec := YAMLStatefulSetSpec{
+		StatefulSetSpec: v1apps.StatefulSetSpec{
+			Selector: &v12.LabelSelector{
+				MatchLabels: matchLabels,
+			},
+			ServiceName:          pod.Name,
+			VolumeClaimTemplates: claimTemplates,
+		},
+		Template: &YAMLPodTemplateSpec{
+			PodTemplateSpec: v1.PodTemplateSpec{
+				ObjectMeta: pod.ObjectMeta,
+			},
+			Spec: &podSpec,
+		},
+	}
+
+	// As for Deployments, only add replicas if greater than 1.
+	if options.Replicas > 1 {
+		stsSpec.Replicas = &options.Replicas
+	}
+
+	// Create the StatefulSet object
+	sts := YAMLStatefulSet{
+		StatefulSet: v1apps.StatefulSet{
+			ObjectMeta: v12.ObjectMeta{
+				Name:              pod.Name + "-statefulset",
+				CreationTimestamp: pod.CreationTimestamp,
+				Labels:            pod.Labels,
+			},
+			TypeMeta: v12.TypeMeta{
+				Kind:       "StatefulSet",
+				APIVersion: "apps/v1",
+			},
+		},
+		Spec: &stsSpec,
+	}
+
+	return &sts, nil
+}
+
 // GenerateForKubeJob returns a YAMLDeployment from a YAMLPod that is then used to create a kubernetes Job
 // kind YAML.
 func GenerateForKubeJob(_ context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLJob, error) {
@@ -415,6 +500,28 @@ type YAMLDeployment struct {
 	Status *v1.DeploymentStatus `json:"status,omitempty"`
 }
 
+// YAMLStatefulSetSpec represents the same k8s API core StatefulSetSpec with a small
+// change and that is having Template as a pointer to YAMLPodTemplateSpec and UpdateStrategy
+// as a pointer to k8s API core StatefulSetUpdateStrategy.
+// Because Go doesn't omit empty struct and we want to omit UpdateStrategy and any fields in the Pod YAML
+// if it's empty.
+type YAMLStatefulSetSpec struct {
+	v1apps.StatefulSetSpec
+	Template       *YAMLPodTemplateSpec              `json:"template,omitempty"`
+	UpdateStrategy *v1apps.StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// YAMLStatefulSet represents the same k8s API core StatefulSet with a small change
+// and that is having Spec as a pointer to YAMLStatefulSetSpec and Status as a pointer to
+// k8s API core StatefulSetStatus.
+// Because Go doesn't omit empty struct and we want to omit Status and any fields in the StatefulSetSpec
+// if it's empty.
+type YAMLStatefulSet struct {
+	v1apps.StatefulSet
+	Spec   *YAMLStatefulSetSpec      `json:"spec,omitempty"`
+	Status *v1apps.StatefulSetStatus `json:"status,omitempty"`
+}
+
 type YAMLJob struct {
 	v1.Job
 	Spec   *YAMLJobSpec  `json:"spec,omitempty"`
@@ -0,0 +1,519 @@
+package logs
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nxadm/tail"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/storage/pkg/ioutils"
+)
+
+// Containers using the json-file log driver store their log as structured
+// JSON records, one per line.  Conmon only writes the k8s-file format, so it
+// writes the lines of the container to a capture file next to the log,
+// which is ingested into the log by Podman while the container runs:
+// complete lines are converted into records, partial lines being joined with
+// the full line ending them.  The offset up to which the capture file was
+// ingested is stored next to the log, too.  The log is only ingested with
+// the container locked, so it is read with the container locked as well.
+const (
+	captureSuffix  = ".capture"
+	ingestedSuffix = ".ingested"
+
+	// captureReopenTimeout is the time after which conmon reopened the
+	// capture file once signaled, so a rotated capture file which was
+	// written to more recently may still be written to.
+	captureReopenTimeout = time.Second
+)
+
+// JSONLogLimits are the limits of a log of the json-file log driver, which
+// are enforced while its records are written.
+type JSONLogLimits struct {
+	// MaxSize is the maximum size of the log.  A log reaching it is
+	// rotated if MaxFiles is set, or truncated otherwise.
+	MaxSize int64
+	// MaxFiles is the number of rotated log files kept.
+	MaxFiles int
+	// Compress compresses the rotated log files.
+	Compress bool
+}
+
+// jsonLogRecord is a record of the json-file log driver.
+type jsonLogRecord struct {
+	Time   time.Time `json:"time"`
+	Stream string    `json:"stream"`
+	Log    string    `json:"log"`
+	// Partial is set for a line whose full line was never written.
+	Partial bool `json:"partial,omitempty"`
+	// Fields are the fields of the JSON object in Log, see
+	// LogLine.Fields.
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// CaptureLogPath returns the path of the file conmon writes the lines of a
+// container using the json-file log driver with the log at path to.
+func CaptureLogPath(path string) string {
+	return path + captureSuffix
+}
+
+// IngestedOffsetPath returns the path of the file holding the offset up to
+// which the capture file was ingested into the log at path.
+func IngestedOffsetPath(path string) string {
+	return path + ingestedSuffix
+}
+
+// NewJSONLogLine creates a LogLine from a record of the json-file log driver.
+func NewJSONLogLine(line string) (*LogLine, error) {
+	var record jsonLogRecord
+	if err := json.Unmarshal([]byte(line), &record); err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid container log record: %w", line, err)
+	}
+	if record.Stream == "" {
+		return nil, fmt.Errorf("'%s' is not a valid container log record: missing stream", line)
+	}
+	l := LogLine{
+		Time:         record.Time,
+		Device:       record.Stream,
+		ParseLogType: FullLogType,
+		Msg:          record.Log,
+		payload:      record.Fields,
+	}
+	if record.Partial {
+		l.ParseLogType = PartialLogType
+	}
+	return &l, nil
+}
+
+func readIngestedOffset(path string) (int64, error) {
+	data, err := os.ReadFile(IngestedOffsetPath(path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing ingested offset of %s: %w", path, err)
+	}
+	return offset, nil
+}
+
+func writeIngestedOffset(path string, offset int64) error {
+	return ioutils.AtomicWriteFile(IngestedOffsetPath(path), []byte(strconv.FormatInt(offset, 10)), 0o640)
+}
+
+// IngestJSONLog converts the complete lines of the capture file of the log
+// at path into records of the log, which is kept within limits.  Partial
+// lines waiting for their full line stay in the capture file, unless final
+// is set because conmon is not running.  The capture file is then emptied.
+// It returns whether the log was rotated.
+func IngestJSONLog(path string, limits JSONLogLimits, final bool) (bool, error) {
+	offset, err := readIngestedOffset(path)
+	if err != nil {
+		return false, err
+	}
+	capturePath := CaptureLogPath(path)
+	w := jsonLogWriter{path: path, limits: limits}
+	defer w.Close()
+
+	// A rotated capture file is no longer written to once conmon reopened
+	// the capture file, so it is ingested entirely before the current one.
+	rotatedPath := rotatedLogPath(capturePath, 1, false)
+	if info, err := os.Stat(rotatedPath); err == nil {
+		if !final && time.Since(info.ModTime()) < captureReopenTimeout {
+			return false, nil
+		}
+		if _, err := ingestCaptureFile(&w, rotatedPath, offset, true); err != nil {
+			return w.rotated, err
+		}
+		if err := w.Close(); err != nil {
+			return w.rotated, err
+		}
+		if err := os.Remove(rotatedPath); err != nil {
+			return w.rotated, err
+		}
+		offset = 0
+		if err := writeIngestedOffset(path, offset); err != nil {
+			return w.rotated, err
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	offset, err = ingestCaptureFile(&w, capturePath, offset, final)
+	if err != nil {
+		return w.rotated, err
+	}
+	if err := w.Close(); err != nil {
+		return w.rotated, err
+	}
+	if final {
+		// The capture file is kept, so the log can be followed.
+		if err := os.Truncate(capturePath, 0); err != nil {
+			return w.rotated, err
+		}
+		if err := os.Remove(IngestedOffsetPath(path)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return w.rotated, err
+		}
+		return w.rotated, nil
+	}
+	return w.rotated, writeIngestedOffset(path, offset)
+}
+
+// ingestCaptureFile writes the records of the lines of the capture file
+// after offset to w and returns the offset up to which the capture file was
+// ingested.  Unless final is set, lines are only ingested up to the first
+// partial line waiting for its full line.
+func ingestCaptureFile(w *jsonLogWriter, capturePath string, offset int64, final bool) (int64, error) {
+	f, err := os.OpenFile(capturePath, os.O_RDONLY|os.O_CREATE, 0o640)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() == offset {
+		return offset, nil
+	}
+	// The capture file was emptied by a final ingestion.
+	if info.Size() < offset {
+		offset = 0
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	var (
+		joiner PartialJoiner
+		held   []*LogLine
+	)
+	pos, ingested := offset, offset
+	br := bufio.NewReader(f)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return 0, err
+			}
+			// A line not completely written yet is ingested later.
+			break
+		}
+		pos += int64(len(line))
+		nll, err := NewLogLine(strings.TrimSuffix(line, "\n"))
+		if err != nil {
+			logrus.Debugf("Skipping line of %s: %v", capturePath, err)
+		} else if nll = joiner.Join(nll); nll != nil {
+			held = append(held, nll)
+		}
+		// Lines of another stream following a partial line are held
+		// back with it, so the lines after the ingested offset are
+		// exactly the ones not ingested yet.
+		if !joiner.Pending() {
+			if err := w.Write(held); err != nil {
+				return 0, err
+			}
+			held = held[:0]
+			ingested = pos
+		}
+	}
+	if final {
+		if err := w.Write(append(held, joiner.Flush()...)); err != nil {
+			return 0, err
+		}
+		ingested = pos
+	}
+	return ingested, nil
+}
+
+// jsonLogWriter writes records to a log of the json-file log driver,
+// keeping it within its limits like conmon does for the k8s-file log
+// driver: a record which would make the log exceed its maximum size is
+// written to a new log.
+type jsonLogWriter struct {
+	path    string
+	limits  JSONLogLimits
+	file    *os.File
+	w       *bufio.Writer
+	size    int64
+	rotated bool
+}
+
+func (w *jsonLogWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o640)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.file, w.w, w.size = f, bufio.NewWriter(f), info.Size()
+	return nil
+}
+
+// Write writes the records of the log lines.
+func (w *jsonLogWriter) Write(logLines []*LogLine) error {
+	for _, nll := range logLines {
+		b, err := json.Marshal(jsonLogRecord{
+			Time:    nll.Time,
+			Stream:  nll.Device,
+			Log:     nll.Msg,
+			Partial: nll.Partial(),
+			Fields:  payloadFields(nll.Msg),
+		})
+		if err != nil {
+			return err
+		}
+		b = append(b, '\n')
+		if w.file == nil {
+			if err := w.open(); err != nil {
+				return err
+			}
+		}
+		if w.limits.MaxSize > 0 && w.size > 0 && w.size+int64(len(b)) > w.limits.MaxSize {
+			if err := w.cut(); err != nil {
+				return err
+			}
+		}
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+		w.size += int64(len(b))
+	}
+	return nil
+}
+
+// cut rotates the log if rotated log files are kept, or truncates it
+// otherwise, and opens the new log.
+func (w *jsonLogWriter) cut() error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if w.limits.MaxFiles > 0 {
+		if err := RotateLogFile(w.path, w.limits.MaxFiles, w.limits.Compress); err != nil {
+			return err
+		}
+		w.rotated = true
+	} else if err := os.Truncate(w.path, 0); err != nil {
+		return err
+	}
+	return w.open()
+}
+
+// Close flushes the records written and closes the log.
+func (w *jsonLogWriter) Close() error {
+	if w.file == nil {
+		return nil
+	}
+	f := w.file
+	w.file = nil
+	if err := w.w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// RotateCaptureLog moves the capture file of the log at path aside, so it is
+// ingested entirely by IngestJSONLog once conmon reopened the capture file.
+// It returns whether the capture file was moved.
+func RotateCaptureLog(path string) (bool, error) {
+	capturePath := CaptureLogPath(path)
+	rotatedPath := rotatedLogPath(capturePath, 1, false)
+	// The capture file moved aside before was not ingested yet.
+	if _, err := os.Stat(rotatedPath); !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	info, err := os.Stat(capturePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	if info.Size() == 0 {
+		return false, nil
+	}
+	if err := os.Rename(capturePath, rotatedPath); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetJSONLogFile returns an hp tail for the capture file of the log at path,
+// which is of the json-file log driver, given options.  With a tail, the
+// records of the log and its rotated log files needed for it are returned
+// along with the lines of the capture file which were not ingested yet.
+// Without a tail, these lines are read with StoredJSONLogLines.
+func GetJSONLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error) {
+	capturePath := CaptureLogPath(path)
+	offset, err := readIngestedOffset(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var logTail []*LogLine
+	seek := tail.SeekInfo{Whence: io.SeekEnd}
+	switch {
+	case options.Tail > 0:
+		// The offset applies to the rotated capture file while it
+		// was not ingested yet.
+		rotated, err := readLogFile(rotatedLogPath(capturePath, 1, false), offset, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		if rotated != nil {
+			offset = 0
+		}
+		captured, err := readLogFile(capturePath, offset, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		captured = append(rotated, captured...)
+		logTail = tailLines(captured, int(options.Tail))
+		if remaining := remainingLines(logTail, int(options.Tail)); remaining > 0 {
+			stored, err := getTailLogRotated(path, remaining, options)
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return nil, nil, err
+			}
+			logTail = append(stored, logTail...)
+		}
+	case options.Tail < 0:
+		if _, err := os.Stat(rotatedLogPath(capturePath, 1, false)); err == nil {
+			offset = 0
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, nil, err
+		}
+		seek = tail.SeekInfo{Offset: offset, Whence: io.SeekStart}
+	}
+
+	t, err := tail.TailFile(capturePath, tail.Config{MustExist: true, Poll: true, Follow: options.Follow, Location: &seek, Logger: tail.DiscardingLogger, ReOpen: options.Follow})
+	return t, logTail, err
+}
+
+// StoredJSONLogLines returns an iterator over the lines of the log at path,
+// which is of the json-file log driver, matching the options, the oldest one
+// first.  These are the records of its rotated log files and of the log
+// followed by the lines of the rotated capture file which were not ingested
+// yet.  The log and the rotated capture file are opened right away, so the
+// lines are the ones stored when GetJSONLogFile was called even if the log
+// is ingested before they are consumed.  The files are closed once the
+// iterator is done.
+func StoredJSONLogLines(path string, options *LogOptions) (iter.Seq2[*LogLine, error], error) {
+	offset, err := readIngestedOffset(path)
+	if err != nil {
+		return nil, err
+	}
+	logFile, logSize, err := openLogFile(path, 0)
+	if err != nil {
+		return nil, err
+	}
+	captureFile, _, err := openLogFile(rotatedLogPath(CaptureLogPath(path), 1, false), offset)
+	if err != nil {
+		if logFile != nil {
+			logFile.Close()
+		}
+		return nil, err
+	}
+	return func(yield func(*LogLine, error) bool) {
+		seqs := []iter.Seq2[*LogLine, error]{RotatedLogLines(path, options)}
+		if logFile != nil {
+			defer logFile.Close()
+			// Records appended by a later ingestion are read
+			// from the capture file by the tail.
+			seqs = append(seqs, fileLogLines(logFile, io.LimitReader(logFile, logSize), options))
+		}
+		if captureFile != nil {
+			defer captureFile.Close()
+			seqs = append(seqs, fileLogLines(captureFile, captureFile, options))
+		}
+		for _, seq := range seqs {
+			for nll, err := range seq {
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				if nll.Since(options.Since) && nll.Until(options.Until) && !yield(nll, nil) {
+					return
+				}
+			}
+		}
+	}, nil
+}
+
+// openLogFile opens the log file at path and seeks to offset.  It returns
+// the size of the log file after offset.  A missing log file is not opened.
+func openLogFile(path string, offset int64) (*os.File, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+	info, err := f.Stat()
+	if err == nil {
+		_, err = f.Seek(offset, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, max(info.Size()-offset, 0), nil
+}
+
+// fileLogLines returns an iterator over the lines read from r, which reads
+// the log file f, matching the options.
+func fileLogLines(f *os.File, r io.Reader, options *LogOptions) iter.Seq2[*LogLine, error] {
+	return func(yield func(*LogLine, error) bool) {
+		for nll, err := range logLines(r, options) {
+			if err != nil {
+				yield(nil, fmt.Errorf("reading log file %s: %w", f.Name(), err))
+				return
+			}
+			if !yield(nll, nil) {
+				return
+			}
+		}
+	}
+}
+
+// logFileLines returns an iterator over the lines of the log file at path
+// after offset matching the options.  A missing log file holds no lines.
+func logFileLines(path string, offset int64, options *LogOptions) iter.Seq2[*LogLine, error] {
+	return func(yield func(*LogLine, error) bool) {
+		f, _, err := openLogFile(path, offset)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		if f == nil {
+			return
+		}
+		defer f.Close()
+		for nll, err := range fileLogLines(f, f, options) {
+			if !yield(nll, err) {
+				return
+			}
+		}
+	}
+}
+
+// readLogFile returns the lines of the log file at path after offset
+// matching the options.  A missing log file holds no lines.
+func readLogFile(path string, offset int64, options *LogOptions) ([]*LogLine, error) {
+	return collectLogLines(logFileLines(path, offset, options))
+}
@@ -0,0 +1,122 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func appendTestLog(t *testing.T, path string, lines ...string) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	assert.NoError(t, err, "open log file")
+	defer f.Close()
+	for _, line := range lines {
+		_, err = fmt.Fprintf(f, "2023-08-07T19:56:34.223758260-06:00 %s\n", line)
+		assert.NoError(t, err, "write log file")
+	}
+}
+
+func readTestJSONLog(t *testing.T, path string) []*LogLine {
+	logLines, err := readLogFile(path, 0, &LogOptions{})
+	assert.NoError(t, err, "readLogFile()")
+	return logLines
+}
+
+func ingestTestJSONLog(t *testing.T, path string, limits JSONLogLimits, final bool) bool {
+	rotated, err := IngestJSONLog(path, limits, final)
+	assert.NoError(t, err, "IngestJSONLog()")
+	return rotated
+}
+
+func TestIngestJSONLog(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ctr.log")
+	capture := CaptureLogPath(file)
+
+	appendTestLog(t, capture, "stdout F line 1", `stdout P {"level":"error",`, "stderr F other stream")
+	ingestTestJSONLog(t, file, JSONLogLimits{}, false)
+	// the line of the other stream is held back with the partial line
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line 1")}, readTestJSONLog(t, file), "ingested records")
+
+	appendTestLog(t, capture, `stdout F "req":{"method":"GET"}}`)
+	ingestTestJSONLog(t, file, JSONLogLimits{}, false)
+	got := readTestJSONLog(t, file)
+	assert.Len(t, got, 3, "ingested records")
+	assert.Equal(t, "stderr", got[1].Device, "stream of record")
+	assert.Equal(t, `{"level":"error","req":{"method":"GET"}}`, got[2].Msg, "joined record")
+	assert.Equal(t, map[string]string{"level": "error", "req.method": "GET"}, got[2].payload, "stored fields")
+	assert.True(t, got[2].Matches(&LogOptions{Filters: map[string][]string{"req.method": {"GET"}, "partial": {"false"}}}), "record matches")
+
+	// lines which were not ingested yet are read from the capture file
+	appendTestLog(t, capture, "stdout F line 4", "stdout P line 5")
+	_, logTail, err := GetJSONLogFile(file, &LogOptions{Tail: 2})
+	assert.NoError(t, err, "GetJSONLogFile()")
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line 4"), makeTestLogLine("P", "line 5")}, logTail, "tail log lines")
+	_, logTail, err = GetJSONLogFile(file, &LogOptions{Tail: 3})
+	assert.NoError(t, err, "GetJSONLogFile()")
+	assert.Equal(t, "stdout", logTail[0].Device, "tail continues in the records")
+	assert.Len(t, logTail, 3, "tail log lines")
+
+	// a final ingestion stores the partial line and empties the capture file
+	ingestTestJSONLog(t, file, JSONLogLimits{}, true)
+	got = readTestJSONLog(t, file)
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line 4"), makeTestLogLine("P", "line 5")}, got[3:], "ingested records")
+	info, err := os.Stat(capture)
+	assert.NoError(t, err, "stat capture file")
+	assert.Zero(t, info.Size(), "capture file size")
+	assert.NoFileExists(t, IngestedOffsetPath(file), "ingested offset")
+}
+
+func TestIngestJSONLogRotated(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ctr.log")
+	capture := CaptureLogPath(file)
+
+	appendTestLog(t, capture, "stdout F line 1")
+	ingestTestJSONLog(t, file, JSONLogLimits{}, false)
+	appendTestLog(t, capture, "stdout F line 2")
+	rotated, err := RotateCaptureLog(file)
+	assert.NoError(t, err, "RotateCaptureLog()")
+	assert.True(t, rotated, "capture file rotated")
+	appendTestLog(t, capture, "stdout F line 3")
+
+	// the rotated capture file may still be written to
+	ingestTestJSONLog(t, file, JSONLogLimits{}, false)
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line 1")}, readTestJSONLog(t, file), "ingested records")
+	storedLines, err := StoredJSONLogLines(file, &LogOptions{})
+	assert.NoError(t, err, "StoredJSONLogLines()")
+	// the lines stored when opened are read after another ingestion
+	ingestTestJSONLog(t, file, JSONLogLimits{}, true)
+	stored, err := collectLogLines(storedLines)
+	assert.NoError(t, err, "read stored log lines")
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line 1"), makeTestLogLine("F", "line 2")}, stored, "log lines")
+
+	// the rotated capture file is ingested before the current one
+	want := []*LogLine{makeTestLogLine("F", "line 1"), makeTestLogLine("F", "line 2"), makeTestLogLine("F", "line 3")}
+	assert.Equal(t, want, readTestJSONLog(t, file), "ingested records")
+	assert.NoFileExists(t, rotatedLogPath(capture, 1, false), "rotated capture file")
+}
+
+func TestIngestJSONLogMaxSize(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ctr.log")
+	capture := CaptureLogPath(file)
+	record, err := json.Marshal(jsonLogRecord{Time: makeTestLogLine("F", "").Time, Stream: "stdout", Log: "line 1"})
+	assert.NoError(t, err, "marshal record")
+	limits := JSONLogLimits{MaxSize: int64(len(record)+1) * 2}
+
+	// the log is truncated once it would exceed its maximum size
+	appendTestLog(t, capture, "stdout F line 1", "stdout F line 2", "stdout F line 3")
+	assert.False(t, ingestTestJSONLog(t, file, limits, false), "log rotated")
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line 3")}, readTestJSONLog(t, file), "ingested records")
+
+	// with rotated log files kept, no record is lost
+	limits.MaxFiles = 2
+	appendTestLog(t, capture, "stdout F line 4", "stdout F line 5")
+	assert.True(t, ingestTestJSONLog(t, file, limits, false), "log rotated")
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line 5")}, readTestJSONLog(t, file), "ingested records")
+	stored, err := collectLogLines(RotatedLogLines(file, &LogOptions{}))
+	assert.NoError(t, err, "RotatedLogLines()")
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line 3"), makeTestLogLine("F", "line 4")}, stored, "rotated log lines")
+}
@@ -1,10 +1,15 @@
 package logs
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -44,6 +49,18 @@ type LogOptions struct {
 	Multi      bool
 	WaitGroup  *sync.WaitGroup
 	UseName    bool
+	// Grep only selects log lines whose message matches the pattern.
+	Grep *regexp.Regexp
+	// Filters only selects log lines whose fields match, see
+	// LogLine.Matches.
+	Filters map[string][]string
+}
+
+// Filtering returns whether log lines are selected by a grep pattern or
+// filters.  Partial lines are then joined with the following ones up to the
+// full line, so they are selected as a whole.
+func (o *LogOptions) Filtering() bool {
+	return o != nil && (o.Grep != nil || len(o.Filters) > 0)
 }
 
 // LogLine describes the information for each line of a log
@@ -55,9 +72,15 @@ type LogLine struct {
 	CID          string
 	CName        string
 	ColorID      int64
+	// payload holds the fields of the JSON object in Msg, if they were
+	// parsed when the line was stored.
+	payload map[string]string
 }
 
-// GetLogFile returns an hp tail for a container given options
+// GetLogFile returns an hp tail for a container given options.  The lines
+// of the rotated log files needed for the tail are returned along with the
+// tail lines.  Without a tail, the lines of the rotated log files are read
+// with RotatedLogLines.
 func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error) {
 	var (
 		whence  int
@@ -69,7 +92,7 @@ func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error
 		whence = 2
 	}
 	if options.Tail > 0 {
-		logTail, err = getTailLog(path, int(options.Tail))
+		logTail, err = getTailLogRotated(path, int(options.Tail), options)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -83,12 +106,47 @@ func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error
 	return t, logTail, err
 }
 
-func getTailLog(path string, tail int) ([]*LogLine, error) {
+// getTailLogRotated returns the last tail lines of the log file at path
+// matching the options, continued in its rotated log files if the log file
+// does not hold enough lines.
+func getTailLogRotated(path string, tail int, options *LogOptions) ([]*LogLine, error) {
+	logTail, err := getTailLog(path, tail, options)
+	if err != nil {
+		return nil, err
+	}
+	if remaining := remainingLines(logTail, tail); remaining > 0 {
+		rotatedTail, err := getRotatedTailLog(path, remaining, options)
+		if err != nil {
+			return nil, err
+		}
+		logTail = append(rotatedTail, logTail...)
+	}
+	return logTail, nil
+}
+
+// remainingLines returns the number of lines missing in logTail for the
+// tail.  A trailing partial line is printed as a line, too.
+func remainingLines(logTail []*LogLine, tail int) int {
+	remaining := tail - fullLines(logTail)
+	if len(logTail) > 0 && logTail[len(logTail)-1].Partial() {
+		remaining--
+	}
+	return remaining
+}
+
+// getTailLog returns the last tail lines of the log matching the options.
+// Unless filtering, partial lines are returned as they are and only full
+// lines are counted.
+func getTailLog(path string, tail int, options *LogOptions) ([]*LogLine, error) {
 	var (
 		nllCounter int
 		leftover   string
 		tailLog    []*LogLine
 		eof        bool
+		// When filtering, partial lines are joined with the full
+		// line ending them in group before they are matched.
+		join  = options.Filtering()
+		group *LogLine
 	)
 	f, err := os.Open(path)
 	if err != nil {
@@ -118,10 +176,26 @@ func getTailLog(path string, tail int) ([]*LogLine, error) {
 			if lines[i] == "" {
 				continue
 			}
-			nll, err := NewLogLine(lines[i])
+			nll, err := parseLogLine(lines[i])
 			if err != nil {
 				return nil, err
 			}
+			if join {
+				// The line ends the partial lines read so far,
+				// unless it is partial itself.
+				if nll.Partial() && group != nil {
+					group = joinLogLines(nll, group)
+					continue
+				}
+				if group != nil && group.Matches(options) {
+					if len(tailLog) == tail {
+						return reverseLog(tailLog), nil
+					}
+					tailLog = append(tailLog, group)
+				}
+				group = nll
+				continue
+			}
 			if !nll.Partial() || first {
 				nllCounter++
 				// Even if the last line is partial we need to count it as it will be printed as line.
@@ -143,12 +217,25 @@ func getTailLog(path string, tail int) ([]*LogLine, error) {
 		// eof was reached
 		if eof {
 			// when we have still a line and do not have enough tail lines already
-			if leftover != "" && nllCounter < tail {
-				nll, err := NewLogLine(leftover)
+			if leftover != "" && (join || nllCounter < tail) {
+				nll, err := parseLogLine(leftover)
 				if err != nil {
 					return nil, err
 				}
-				tailLog = append(tailLog, nll)
+				switch {
+				case !join:
+					tailLog = append(tailLog, nll)
+				case nll.Partial() && group != nil:
+					group = joinLogLines(nll, group)
+				default:
+					if group != nil && group.Matches(options) && len(tailLog) < tail {
+						tailLog = append(tailLog, group)
+					}
+					group = nll
+				}
+			}
+			if group != nil && group.Matches(options) && len(tailLog) < tail {
+				tailLog = append(tailLog, group)
 			}
 			// because we add lines in the inverse order we must invert the slice in the end
 			return reverseLog(tailLog), nil
@@ -222,6 +309,77 @@ func (l *LogLine) Until(until time.Time) bool {
 	return l.Time.Before(until) || until.IsZero()
 }
 
+// Matches returns whether the log line is selected by the grep pattern and
+// the filters of the options.  Filters of different fields must all match,
+// while any of the values of a filter is sufficient.
+func (l *LogLine) Matches(options *LogOptions) bool {
+	if options == nil {
+		return true
+	}
+	if options.Grep != nil && !options.Grep.MatchString(l.Msg) {
+		return false
+	}
+	if len(options.Filters) == 0 {
+		return true
+	}
+	fields := l.Fields()
+	for name, values := range options.Filters {
+		value, ok := fields[name]
+		if !ok || !slices.Contains(values, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Fields returns the fields of the log line.  These are the stream and the
+// partial flag of the line and, if the message holds a JSON object, the
+// fields of the object with the names of nested fields joined by dots.
+// Values which are no strings are returned in their JSON encoding.
+func (l *LogLine) Fields() map[string]string {
+	payload := l.payload
+	if payload == nil {
+		payload = payloadFields(l.Msg)
+	}
+	fields := make(map[string]string, len(payload)+2)
+	maps.Copy(fields, payload)
+	fields["stream"] = l.Device
+	fields["partial"] = strconv.FormatBool(l.Partial())
+	return fields
+}
+
+// payloadFields returns the fields of the JSON object in msg, or nil if msg
+// does not hold a JSON object.
+func payloadFields(msg string) map[string]string {
+	if !strings.HasPrefix(msg, "{") {
+		return nil
+	}
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(msg), &payload); err != nil {
+		return nil
+	}
+	fields := make(map[string]string)
+	addPayloadFields(fields, "", payload)
+	return fields
+}
+
+func addPayloadFields(fields map[string]string, prefix string, payload map[string]any) {
+	for key, value := range payload {
+		switch v := value.(type) {
+		case map[string]any:
+			addPayloadFields(fields, prefix+key+".", v)
+		case string:
+			fields[prefix+key] = v
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			fields[prefix+key] = string(encoded)
+		}
+	}
+}
+
 // NewLogLine creates a logLine struct from a container log string
 func NewLogLine(line string) (*LogLine, error) {
 	splitLine := strings.Split(line, " ")
@@ -241,6 +399,71 @@ func NewLogLine(line string) (*LogLine, error) {
 	return &l, nil
 }
 
+// parseLogLine creates a LogLine from a line of a log file, which is either
+// in the k8s-file format or a record of the json-file log driver.
+func parseLogLine(line string) (*LogLine, error) {
+	if strings.HasPrefix(line, "{") {
+		return NewJSONLogLine(line)
+	}
+	return NewLogLine(line)
+}
+
+// joinLogLines returns the partial log line first joined with the following
+// line next.
+func joinLogLines(first, next *LogLine) *LogLine {
+	joined := *next
+	joined.Time = first.Time
+	joined.Msg = first.Msg + next.Msg
+	joined.payload = nil
+	return &joined
+}
+
+// PartialJoiner joins partial log lines with the following lines of the same
+// stream up to the full line.
+type PartialJoiner struct {
+	pending map[string]*LogLine
+	order   []string
+}
+
+// Join returns the full log line, joined with the partial lines of its
+// stream before it, or nil if the line is partial.
+func (j *PartialJoiner) Join(nll *LogLine) *LogLine {
+	if pending, ok := j.pending[nll.Device]; ok {
+		nll = joinLogLines(pending, nll)
+	}
+	if nll.Partial() {
+		if j.pending == nil {
+			j.pending = make(map[string]*LogLine)
+		}
+		if _, ok := j.pending[nll.Device]; !ok {
+			j.order = append(j.order, nll.Device)
+		}
+		j.pending[nll.Device] = nll
+		return nil
+	}
+	if _, ok := j.pending[nll.Device]; ok {
+		delete(j.pending, nll.Device)
+		j.order = slices.DeleteFunc(j.order, func(device string) bool { return device == nll.Device })
+	}
+	return nll
+}
+
+// Pending returns whether partial lines are waiting for their full line.
+func (j *PartialJoiner) Pending() bool {
+	return len(j.order) > 0
+}
+
+// Flush returns the joined partial lines waiting for their full line, the
+// oldest one first, and forgets them.
+func (j *PartialJoiner) Flush() []*LogLine {
+	logLines := make([]*LogLine, 0, len(j.order))
+	for _, device := range j.order {
+		logLines = append(logLines, j.pending[device])
+	}
+	j.pending, j.order = nil, nil
+	return logLines
+}
+
 // Partial returns a bool if the log line is a partial log type
 func (l *LogLine) Partial() bool {
 	return l.ParseLogType == PartialLogType
@@ -3,6 +3,7 @@ package logs
 import (
 	"os"
 	"path/filepath"
+	"regexp"
 	"testing"
 	"time"
 
@@ -103,7 +104,7 @@ func TestGetTailLog(t *testing.T) {
 			_, err = f.WriteString(tt.fileContent)
 			assert.NoError(t, err, "write log file")
 			f.Close()
-			got, err := getTailLog(file, tt.tail)
+			got, err := getTailLog(file, tt.tail, &LogOptions{})
 			assert.NoError(t, err, "getTailLog()")
 			assert.Equal(t, tt.want, got, "log lines")
 		})
@@ -126,13 +127,138 @@ func TestGetTailLogBigFiles(t *testing.T) {
 	f.Close()
 
 	// try a big tail greater than the lines
-	got, err := getTailLog(file, 5000)
+	got, err := getTailLog(file, 5000, nil)
 	assert.NoError(t, err, "getTailLog()")
 	assert.Equal(t, want, got, "all log lines")
 
 	// try a smaller than lines tail
-	got, err = getTailLog(file, 100)
+	got, err = getTailLog(file, 100, nil)
 	assert.NoError(t, err, "getTailLog()")
 	// this will return the last 200 lines because of partial + full and we only count full lines for tail.
 	assert.Equal(t, want[1800:2000], got, "tail 100 log lines")
 }
+
+func TestGetTailLogMatches(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "log")
+	err := os.WriteFile(file, []byte(`2023-08-07T19:56:34.223758260-06:00 stdout F error 1
+2023-08-07T19:56:34.223758260-06:00 stdout F info 2
+2023-08-07T19:56:34.223758260-06:00 stdout F error 3
+2023-08-07T19:56:34.223758260-06:00 stdout F info 4
+`), 0o600)
+	assert.NoError(t, err, "write log file")
+
+	// tail counts only the matching lines
+	got, err := getTailLog(file, 2, &LogOptions{Grep: regexp.MustCompile("^error")})
+	assert.NoError(t, err, "getTailLog()")
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "error 1"), makeTestLogLine("F", "error 3")}, got, "log lines")
+}
+
+func TestGetTailLogMatchesPartial(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "log")
+	err := os.WriteFile(file, []byte(`2023-08-07T19:56:34.223758260-06:00 stdout P err
+2023-08-07T19:56:34.223758260-06:00 stdout F or 1
+2023-08-07T19:56:34.223758260-06:00 stdout F info 2
+2023-08-07T19:56:34.223758260-06:00 stdout P {"level":
+2023-08-07T19:56:34.223758260-06:00 stdout F "error"}
+2023-08-07T19:56:34.223758260-06:00 stdout P error
+`), 0o600)
+	assert.NoError(t, err, "write log file")
+
+	// partial lines are joined before they are matched
+	got, err := getTailLog(file, 10, &LogOptions{Grep: regexp.MustCompile("^error")})
+	assert.NoError(t, err, "getTailLog()")
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "error 1"), makeTestLogLine("P", "error")}, got, "log lines")
+
+	got, err = getTailLog(file, 1, &LogOptions{Filters: map[string][]string{"level": {"error"}}})
+	assert.NoError(t, err, "getTailLog()")
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", `{"level":"error"}`)}, got, "log lines")
+}
+
+func TestPartialJoiner(t *testing.T) {
+	stderr := makeTestLogLine("F", "stderr")
+	stderr.Device = "stderr"
+
+	var joiner PartialJoiner
+	assert.Nil(t, joiner.Join(makeTestLogLine("P", "li")))
+	assert.Nil(t, joiner.Join(makeTestLogLine("P", "ne")))
+	// lines of other streams are not joined
+	assert.Equal(t, stderr, joiner.Join(stderr))
+	assert.True(t, joiner.Pending())
+	assert.Equal(t, makeTestLogLine("F", "line 1"), joiner.Join(makeTestLogLine("F", " 1")))
+	assert.False(t, joiner.Pending())
+
+	assert.Nil(t, joiner.Join(makeTestLogLine("P", "line 2")))
+	assert.Equal(t, []*LogLine{makeTestLogLine("P", "line 2")}, joiner.Flush())
+	assert.False(t, joiner.Pending())
+}
+
+func TestLogLineMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    *LogLine
+		options *LogOptions
+		want    bool
+	}{
+		{
+			name:    "no options",
+			line:    makeTestLogLine("F", "hello"),
+			options: nil,
+			want:    true,
+		},
+		{
+			name:    "grep",
+			line:    makeTestLogLine("F", "GET /index.html 200"),
+			options: &LogOptions{Grep: regexp.MustCompile(`\s200$`)},
+			want:    true,
+		},
+		{
+			name:    "grep without match",
+			line:    makeTestLogLine("F", "GET /index.html 404"),
+			options: &LogOptions{Grep: regexp.MustCompile(`\s200$`)},
+			want:    false,
+		},
+		{
+			name:    "stream and partial",
+			line:    makeTestLogLine("P", "hello"),
+			options: &LogOptions{Filters: map[string][]string{"stream": {"stdout"}, "partial": {"true"}}},
+			want:    true,
+		},
+		{
+			name:    "stream without match",
+			line:    makeTestLogLine("F", "hello"),
+			options: &LogOptions{Filters: map[string][]string{"stream": {"stderr"}}},
+			want:    false,
+		},
+		{
+			name:    "json fields",
+			line:    makeTestLogLine("F", `{"level":"error","status":500,"req":{"method":"GET"}}`),
+			options: &LogOptions{Filters: map[string][]string{"level": {"warn", "error"}, "status": {"500"}, "req.method": {"GET"}}},
+			want:    true,
+		},
+		{
+			name:    "json field without match",
+			line:    makeTestLogLine("F", `{"level":"info"}`),
+			options: &LogOptions{Filters: map[string][]string{"level": {"warn", "error"}}},
+			want:    false,
+		},
+		{
+			name:    "missing field",
+			line:    makeTestLogLine("F", `level=error`),
+			options: &LogOptions{Filters: map[string][]string{"level": {"error"}}},
+			want:    false,
+		},
+		{
+			name:    "grep and filter",
+			line:    makeTestLogLine("F", `{"level":"error","msg":"timeout"}`),
+			options: &LogOptions{Grep: regexp.MustCompile("refused"), Filters: map[string][]string{"level": {"error"}}},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.line.Matches(tt.options))
+		})
+	}
+}
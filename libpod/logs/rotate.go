@@ -0,0 +1,355 @@
+package logs
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// compressedSuffix is appended to the name of compressed rotated log files.
+const compressedSuffix = ".gz"
+
+// logSegment is a rotated log file.  Rotated log files are named after the
+// log file with the index of the rotation appended, the most recent one
+// having index 1.
+type logSegment struct {
+	index      int
+	path       string
+	compressed bool
+}
+
+func rotatedLogPath(path string, index int, compressed bool) string {
+	name := fmt.Sprintf("%s.%d", path, index)
+	if compressed {
+		name += compressedSuffix
+	}
+	return name
+}
+
+// rotatedLogSegments returns the rotated log files of the log file at path,
+// the most recent one first.
+func rotatedLogSegments(path string) ([]logSegment, error) {
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	prefix := filepath.Base(path) + "."
+	var segments []logSegment
+	for _, entry := range entries {
+		suffix, ok := strings.CutPrefix(entry.Name(), prefix)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		suffix, compressed := strings.CutSuffix(suffix, compressedSuffix)
+		index, err := strconv.Atoi(suffix)
+		if err != nil || index < 1 {
+			continue
+		}
+		segments = append(segments, logSegment{
+			index:      index,
+			path:       filepath.Join(filepath.Dir(path), entry.Name()),
+			compressed: compressed,
+		})
+	}
+	slices.SortFunc(segments, func(a, b logSegment) int {
+		return a.index - b.index
+	})
+	return segments, nil
+}
+
+// RotatedLogFiles returns the paths of the rotated log files of the log file
+// at path, the most recent one first.
+func RotatedLogFiles(path string) ([]string, error) {
+	segments, err := rotatedLogSegments(path)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		files = append(files, segment.path)
+	}
+	return files, nil
+}
+
+// NeedsRotation returns whether the log file at path is not empty and has
+// reached maxSize bytes or holds a line older than maxAge.  Limits of zero
+// are not checked.
+func NeedsRotation(path string, maxSize int64, maxAge time.Duration) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	if info.Size() == 0 {
+		return false, nil
+	}
+	if maxSize > 0 && info.Size() >= maxSize {
+		return true, nil
+	}
+	if maxAge <= 0 {
+		return false, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	nll, err := parseLogLine(strings.TrimSuffix(line, "\n"))
+	if err != nil {
+		return false, err
+	}
+	return time.Since(nll.Time) >= maxAge, nil
+}
+
+// RotateLogFile moves the log file at path to its first rotated log file,
+// shifting the existing rotated log files.  At most maxFiles rotated log
+// files are kept.  If compress is set, the rotated log files are compressed
+// with gzip, except for the most recent one which may still be written to
+// until the writer of the log reopens it.
+func RotateLogFile(path string, maxFiles int, compress bool) error {
+	segments, err := rotatedLogSegments(path)
+	if err != nil {
+		return err
+	}
+	for _, segment := range slices.Backward(segments) {
+		if segment.index >= maxFiles {
+			if err := os.Remove(segment.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			continue
+		}
+		target := rotatedLogPath(path, segment.index+1, compress || segment.compressed)
+		if compress && !segment.compressed {
+			err = compressLogFile(segment.path, target)
+		} else {
+			err = os.Rename(segment.path, target)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if maxFiles < 1 {
+		return nil
+	}
+	if err := os.Rename(path, rotatedLogPath(path, 1, false)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// compressLogFile writes the log file at path compressed to target and
+// removes it.
+func compressLogFile(path, target string) (retErr error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	dst, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := dst.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+		if retErr != nil {
+			os.Remove(target)
+		}
+	}()
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// logSegmentLines returns an iterator over the lines of the rotated log file
+// matching the options, the oldest one first.
+func logSegmentLines(segment logSegment, options *LogOptions) iter.Seq2[*LogLine, error] {
+	return func(yield func(*LogLine, error) bool) {
+		f, err := os.Open(segment.path)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer f.Close()
+		var r io.Reader = f
+		if segment.compressed {
+			zr, err := gzip.NewReader(f)
+			if err != nil {
+				yield(nil, fmt.Errorf("reading rotated log file %s: %w", segment.path, err))
+				return
+			}
+			defer zr.Close()
+			r = zr
+		}
+		for nll, err := range logLines(r, options) {
+			if err != nil {
+				yield(nil, fmt.Errorf("reading rotated log file %s: %w", segment.path, err))
+				return
+			}
+			if !yield(nll, nil) {
+				return
+			}
+		}
+	}
+}
+
+// readLogSegment returns the lines of the rotated log file matching the
+// options, the oldest one first.
+func readLogSegment(segment logSegment, options *LogOptions) ([]*LogLine, error) {
+	return collectLogLines(logSegmentLines(segment, options))
+}
+
+// logLines returns an iterator over the lines read from r matching the
+// options, the oldest one first.  When filtering, partial lines are joined
+// with their full line first.
+func logLines(r io.Reader, options *LogOptions) iter.Seq2[*LogLine, error] {
+	return func(yield func(*LogLine, error) bool) {
+		var joiner PartialJoiner
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(nil, err)
+				return
+			}
+			if line = strings.TrimSuffix(line, "\n"); line != "" {
+				nll, err := parseLogLine(line)
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				if options.Filtering() {
+					nll = joiner.Join(nll)
+				}
+				if nll != nil && nll.Matches(options) && !yield(nll, nil) {
+					return
+				}
+			}
+			if err != nil {
+				for _, nll := range joiner.Flush() {
+					if nll.Matches(options) && !yield(nll, nil) {
+						return
+					}
+				}
+				return
+			}
+		}
+	}
+}
+
+// collectLogLines returns the log lines of the iterator.
+func collectLogLines(seq iter.Seq2[*LogLine, error]) ([]*LogLine, error) {
+	var logLines []*LogLine
+	for nll, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		logLines = append(logLines, nll)
+	}
+	return logLines, nil
+}
+
+// RotatedLogLines returns an iterator over the lines of all rotated log files
+// of the log file at path matching the options, the oldest one first.  The
+// rotated log files are read as the lines are consumed.
+func RotatedLogLines(path string, options *LogOptions) iter.Seq2[*LogLine, error] {
+	return func(yield func(*LogLine, error) bool) {
+		segments, err := rotatedLogSegments(path)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for _, segment := range slices.Backward(segments) {
+			for nll, err := range logSegmentLines(segment, options) {
+				if err != nil {
+					// The rotated log file was removed by a
+					// rotation in the meantime.
+					if errors.Is(err, os.ErrNotExist) {
+						break
+					}
+					yield(nil, err)
+					return
+				}
+				if nll.Since(options.Since) && nll.Until(options.Until) && !yield(nll, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// getRotatedTailLog returns the last tail lines of the rotated log files of
+// the log file at path matching the options.
+func getRotatedTailLog(path string, tail int, options *LogOptions) ([]*LogLine, error) {
+	segments, err := rotatedLogSegments(path)
+	if err != nil {
+		return nil, err
+	}
+	var logLines []*LogLine
+	for _, segment := range segments {
+		lines, err := readLogSegment(segment, options)
+		if err != nil {
+			return nil, err
+		}
+		logLines = append(lines, logLines...)
+		if fullLines(logLines) >= tail {
+			break
+		}
+	}
+	return tailLines(logLines, tail), nil
+}
+
+// tailLines returns the last tail full lines of logLines along with the
+// partial lines belonging to them.
+func tailLines(logLines []*LogLine, tail int) []*LogLine {
+	counter := 0
+	for i := len(logLines) - 1; i >= 0; i-- {
+		if !logLines[i].Partial() {
+			counter++
+		}
+		if counter > tail {
+			return logLines[i+1:]
+		}
+	}
+	return logLines
+}
+
+// fullLines returns the number of full log lines, partial lines being
+// joined with the next full line when printed.
+func fullLines(logLines []*LogLine) int {
+	counter := 0
+	for _, nll := range logLines {
+		if !nll.Partial() {
+			counter++
+		}
+	}
+	return counter
+}
@@ -0,0 +1,88 @@
+package logs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestLog(t *testing.T, path string, msgs ...string) {
+	f, err := os.Create(path)
+	assert.NoError(t, err, "create log file")
+	defer f.Close()
+	for _, msg := range msgs {
+		_, err = fmt.Fprintf(f, "2023-08-07T19:56:34.223758260-06:00 stdout F %s\n", msg)
+		assert.NoError(t, err, "write log file")
+	}
+}
+
+func TestRotateLogFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ctr.log")
+
+	for i := range 4 {
+		writeTestLog(t, file, fmt.Sprintf("segment %d", i))
+		assert.NoError(t, RotateLogFile(file, 3, true), "RotateLogFile()")
+	}
+	rotated, err := RotatedLogFiles(file)
+	assert.NoError(t, err, "RotatedLogFiles()")
+	assert.Equal(t, []string{file + ".1", file + ".2.gz", file + ".3.gz"}, rotated, "rotated log files")
+
+	// the rotated log files are read across the compressed ones
+	got, err := collectLogLines(RotatedLogLines(file, &LogOptions{}))
+	assert.NoError(t, err, "RotatedLogLines()")
+	want := []*LogLine{makeTestLogLine("F", "segment 1"), makeTestLogLine("F", "segment 2"), makeTestLogLine("F", "segment 3")}
+	assert.Equal(t, want, got, "rotated log lines")
+}
+
+func TestGetLogFileRotated(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ctr.log")
+	writeTestLog(t, file, "line 1", "line 2")
+	assert.NoError(t, RotateLogFile(file, 2, false), "RotateLogFile()")
+	writeTestLog(t, file, "line 3", "line 4")
+	assert.NoError(t, RotateLogFile(file, 2, true), "RotateLogFile()")
+	writeTestLog(t, file, "line 5")
+
+	// the tail continues in the rotated log files
+	_, got, err := GetLogFile(file, &LogOptions{Tail: 4})
+	assert.NoError(t, err, "GetLogFile()")
+	want := []*LogLine{makeTestLogLine("F", "line 2"), makeTestLogLine("F", "line 3"), makeTestLogLine("F", "line 4"), makeTestLogLine("F", "line 5")}
+	assert.Equal(t, want, got, "tail log lines")
+
+	// without a tail the rotated lines are streamed before the log file is read
+	_, got, err = GetLogFile(file, &LogOptions{Tail: -1})
+	assert.NoError(t, err, "GetLogFile()")
+	assert.Empty(t, got, "tail log lines")
+	got, err = collectLogLines(RotatedLogLines(file, &LogOptions{}))
+	assert.NoError(t, err, "RotatedLogLines()")
+	want = []*LogLine{makeTestLogLine("F", "line 1"), makeTestLogLine("F", "line 2"), makeTestLogLine("F", "line 3"), makeTestLogLine("F", "line 4")}
+	assert.Equal(t, want, got, "rotated log lines")
+}
+
+func TestNeedsRotation(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ctr.log")
+
+	rotate, err := NeedsRotation(file, 10, 0)
+	assert.NoError(t, err, "NeedsRotation()")
+	assert.False(t, rotate, "missing log file")
+
+	writeTestLog(t, file, "line 1")
+	rotate, err = NeedsRotation(file, 1024, 0)
+	assert.NoError(t, err, "NeedsRotation()")
+	assert.False(t, rotate, "log file below max size")
+
+	rotate, err = NeedsRotation(file, 10, 0)
+	assert.NoError(t, err, "NeedsRotation()")
+	assert.True(t, rotate, "log file above max size")
+
+	rotate, err = NeedsRotation(file, 0, time.Since(logTime)+time.Hour)
+	assert.NoError(t, err, "NeedsRotation()")
+	assert.False(t, rotate, "log file below max age")
+
+	rotate, err = NeedsRotation(file, 0, time.Hour)
+	assert.NoError(t, err, "NeedsRotation()")
+	assert.True(t, rotate, "log file above max age")
+}
@@ -5,6 +5,7 @@ package libpod
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"regexp"
 	"slices"
@@ -74,8 +75,60 @@ func (c *Container) getNetworkOptions(networkOpts map[string]types.PerNetworkOpt
 
 // setUpNetwork will set up the networks, on error it will also tear down the cni
 // networks. If rootless it will join/create the rootless network namespace.
+// The QoS of the networks is applied once they are set up.
 func (r *Runtime) setUpNetwork(ns string, opts types.NetworkOptions) (map[string]types.StatusBlock, error) {
-	return r.network.Setup(ns, types.SetupOptions{NetworkOptions: opts})
+	qos, err := getNetworkQoS(opts.Networks)
+	if err != nil {
+		return nil, err
+	}
+	opts.Networks = withoutNetworkQoS(opts.Networks)
+	status, err := r.network.Setup(ns, types.SetupOptions{NetworkOptions: opts})
+	if err != nil || len(qos) == 0 {
+		return status, err
+	}
+	if err := setupNetworkQoS(ns, qos); err != nil {
+		if err := r.network.Teardown(ns, types.TeardownOptions{NetworkOptions: opts}); err != nil {
+			logrus.Warnf("failed to teardown network after failed QoS setup: %v", err)
+		}
+		return nil, err
+	}
+	return status, nil
+}
+
+// getNetworkQoS returns the QoS set for the networks, by the name of their
+// network interface.
+func getNetworkQoS(networks map[string]types.PerNetworkOptions) (map[string]*define.NetworkQoS, error) {
+	qos := make(map[string]*define.NetworkQoS)
+	for name, opts := range networks {
+		netQoS, err := define.ParseNetworkQoS(opts.Options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid options for network %s: %w", name, err)
+		}
+		if netQoS != nil {
+			qos[opts.InterfaceName] = netQoS
+		}
+	}
+	return qos, nil
+}
+
+// withoutNetworkQoS returns the networks without the QoS options, which are
+// applied by Podman and not passed to the network backend.
+func withoutNetworkQoS(networks map[string]types.PerNetworkOptions) map[string]types.PerNetworkOptions {
+	stripped := make(map[string]types.PerNetworkOptions, len(networks))
+	for name, opts := range networks {
+		if len(opts.Options) > 0 {
+			options := maps.Clone(opts.Options)
+			for _, option := range define.NetworkQoSOptions {
+				delete(options, option)
+			}
+			if len(options) == 0 {
+				options = nil
+			}
+			opts.Options = options
+		}
+		stripped[name] = opts
+	}
+	return stripped
 }
 
 // getNetworkPodName return the pod name (hostname) used by dns backend.
@@ -93,6 +146,7 @@ func getNetworkPodName(c *Container) string {
 // Tear down a container's network configuration and joins the
 // rootless net ns as rootless user
 func (r *Runtime) teardownNetworkBackend(ns string, opts types.NetworkOptions) error {
+	opts.Networks = withoutNetworkQoS(opts.Networks)
 	return r.network.Teardown(ns, types.TeardownOptions{NetworkOptions: opts})
 }
 
@@ -206,6 +260,7 @@ func (c *Container) getContainerNetworkInfo() (*define.InspectNetworkSettings, e
 
 	settings := new(define.InspectNetworkSettings)
 	settings.Ports = makeInspectPortBindings(c.config.PortMappings)
+	settings.EgressRules = c.state.EgressRules
 
 	networks, err := c.networks()
 	if err != nil {
@@ -485,6 +540,9 @@ func (c *Container) NetworkConnect(nameOrID, netName string, netOpts types.PerNe
 	if err := isBridgeNetMode(c.config.NetMode); err != nil {
 		return err
 	}
+	if _, err := define.ParseNetworkQoS(netOpts.Options); err != nil {
+		return fmt.Errorf("invalid options for network %s: %w", netName, err)
+	}
 
 	c.lock.Lock()
 	defer c.lock.Unlock()
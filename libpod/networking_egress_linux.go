@@ -0,0 +1,193 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/libnetwork/resolvconf"
+	"go.podman.io/common/libnetwork/types"
+)
+
+// egressTable is the nftables table holding the egress allow list in the
+// network namespace of a container.
+const egressTable = "podman_egress"
+
+// setupEgressAllow installs the egress allow list of the container as
+// nftables rules in its network namespace.  Only traffic to the allowed
+// destinations, to the DNS servers of the container and replies to
+// connections made to the container are sent, all other traffic is dropped.
+func (r *Runtime) setupEgressAllow(ctr *Container, netns string, status map[string]types.StatusBlock) error {
+	if len(ctr.config.EgressAllow) == 0 {
+		return nil
+	}
+	rules, err := resolveEgressRules(ctr.config.EgressAllow)
+	if err != nil {
+		return err
+	}
+	if err := r.loadEgressRuleset(ctr, netns, rules, status); err != nil {
+		return err
+	}
+	ctr.state.EgressRules = rules
+	return nil
+}
+
+// reloadEgressAllow installs the active egress allow list of the container
+// again, so the nameservers written to its resolv.conf are allowed, too.
+func (c *Container) reloadEgressAllow() error {
+	if len(c.state.EgressRules) == 0 || c.state.NetNS == "" {
+		return nil
+	}
+	return c.runtime.loadEgressRuleset(c, c.state.NetNS, c.state.EgressRules, c.getNetworkStatus())
+}
+
+// loadEgressRuleset loads the nftables ruleset for the egress allow list
+// rules into the network namespace netns of the container.
+func (r *Runtime) loadEgressRuleset(ctr *Container, netns string, rules []define.EgressRule, status map[string]types.StatusBlock) error {
+	nft, err := r.config.FindHelperBinary("nft", true)
+	if err != nil {
+		return fmt.Errorf("an egress allow list requires nft: %w", err)
+	}
+	ruleset := egressRuleset(rules, egressDNSServers(ctr, status))
+
+	err = ns.WithNetNSPath(netns, func(_ ns.NetNS) error {
+		cmd := exec.Command(nft, "-f", "-")
+		cmd.Stdin = strings.NewReader(ruleset)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("installing egress allow list: %s: %w", strings.TrimSpace(string(out)), err)
+		}
+		return nil
+	})
+	return err
+}
+
+// resolveEgressRules returns the rules for the entries of an egress allow
+// list.  Host names are resolved to all their addresses.
+func resolveEgressRules(allow []string) ([]define.EgressRule, error) {
+	rules := make([]define.EgressRule, 0, len(allow))
+	for _, entry := range allow {
+		host, port, err := define.ParseEgressAllow(entry)
+		if err != nil {
+			return nil, err
+		}
+		rule := define.EgressRule{Allow: entry, Port: port}
+		switch {
+		case strings.Contains(host, "/"):
+			_, subnet, _ := net.ParseCIDR(host)
+			rule.Destinations = []string{subnet.String()}
+		case net.ParseIP(host) != nil:
+			rule.Destinations = []string{net.ParseIP(host).String()}
+		default:
+			ips, err := net.LookupIP(host)
+			if err != nil {
+				return nil, fmt.Errorf("resolving egress allow entry %q: %w", entry, err)
+			}
+			for _, ip := range ips {
+				rule.Destinations = append(rule.Destinations, ip.String())
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// egressDNSServers returns the DNS servers which are set up for the
+// container by Podman and must stay reachable.
+func egressDNSServers(ctr *Container, status map[string]types.StatusBlock) []string {
+	var servers []string
+	for _, block := range status {
+		for _, ip := range block.DNSServerIPs {
+			servers = append(servers, ip.String())
+		}
+	}
+	if ctr.pastaResult != nil {
+		servers = append(servers, ctr.pastaResult.DNSForwardIPs...)
+	}
+	for _, ip := range ctr.config.DNSServer {
+		servers = append(servers, ip.String())
+	}
+	// The nameservers of the host or of containers.conf are written to
+	// resolv.conf when no network sets up a DNS server.
+	if path, ok := ctr.state.BindMounts[resolvconf.DefaultResolvConf]; ok {
+		nameservers, err := resolvConfNameservers(path)
+		if err != nil {
+			logrus.Warnf("Reading nameservers of container %s: %v", ctr.ID(), err)
+		}
+		for _, nameserver := range nameservers {
+			if !slices.Contains(servers, nameserver) {
+				servers = append(servers, nameserver)
+			}
+		}
+	}
+	return servers
+}
+
+// resolvConfNameservers returns the addresses of the nameservers in the
+// resolv.conf file at path.  Link-local addresses with a zone are skipped as
+// they cannot be matched by the ruleset.
+func resolvConfNameservers(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var nameservers []string
+	for line := range strings.Lines(string(data)) {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+		if ip := net.ParseIP(fields[1]); ip != nil {
+			nameservers = append(nameservers, ip.String())
+		}
+	}
+	return nameservers, nil
+}
+
+// egressRuleset returns the nftables ruleset for the egress allow list.
+// The table is deleted first so the ruleset can be loaded again.
+func egressRuleset(rules []define.EgressRule, dnsServers []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "table inet %s\ndelete table inet %s\n", egressTable, egressTable)
+	fmt.Fprintf(&b, "table inet %s {\n", egressTable)
+	b.WriteString("\tchain output {\n")
+	b.WriteString("\t\ttype filter hook output priority filter; policy drop;\n")
+	b.WriteString("\t\toifname \"lo\" accept\n")
+	b.WriteString("\t\tct state established,related accept\n")
+	b.WriteString("\t\ticmpv6 type { nd-router-solicit, nd-neighbor-solicit, nd-neighbor-advert } accept\n")
+	for _, server := range dnsServers {
+		writeEgressRule(&b, server, 53)
+	}
+	for _, rule := range rules {
+		for _, destination := range rule.Destinations {
+			writeEgressRule(&b, destination, rule.Port)
+		}
+	}
+	b.WriteString("\t}\n}\n")
+	return b.String()
+}
+
+func writeEgressRule(b *strings.Builder, destination string, port uint16) {
+	family := "ip"
+	if strings.Contains(destination, ":") {
+		family = "ip6"
+	}
+	if port == 0 {
+		fmt.Fprintf(b, "\t\t%s daddr %s accept\n", family, destination)
+		return
+	}
+	for _, proto := range []string{"tcp", "udp"} {
+		fmt.Fprintf(b, "\t\t%s daddr %s %s dport %d accept\n", family, destination, proto, port)
+	}
+}
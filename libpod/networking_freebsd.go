@@ -60,6 +60,9 @@ func (r *Runtime) setupNetNS(ctr *Container) error {
 
 // Create and configure a new network namespace for a container
 func (r *Runtime) configureNetNS(ctr *Container, ctrNS string) (status map[string]types.StatusBlock, rerr error) {
+	if len(ctr.config.EgressAllow) > 0 {
+		return nil, fmt.Errorf("egress allow lists are not supported on FreeBSD: %w", define.ErrNotImplemented)
+	}
 	if err := r.exposeMachinePorts(ctr.config.PortMappings); err != nil {
 		return nil, err
 	}
@@ -90,6 +93,12 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS string) (status map[strin
 	return netStatus, err
 }
 
+// setupNetworkQoS is not supported on FreeBSD, there is no traffic control
+// for the network interfaces of a jail.
+func setupNetworkQoS(_ string, _ map[string]*define.NetworkQoS) error {
+	return fmt.Errorf("network QoS is not supported on FreeBSD: %w", define.ErrNotImplemented)
+}
+
 // Create and configure a new network namespace for a container
 func (r *Runtime) createNetNS(ctr *Container) (n string, q map[string]types.StatusBlock, retErr error) {
 	b := make([]byte, 16)
@@ -229,3 +238,9 @@ func (c *Container) inspectJoinedNetworkNS(_ string) (q types.StatusBlock, retEr
 func (c *Container) reloadRootlessRLKPortMapping() error {
 	return errors.New("unsupported (*Container).reloadRootlessRLKPortMapping")
 }
+
+// reloadEgressAllow does nothing, egress allow lists are not supported on
+// FreeBSD.
+func (c *Container) reloadEgressAllow() error {
+	return nil
+}
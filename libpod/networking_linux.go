@@ -4,6 +4,7 @@ package libpod
 
 import (
 	"fmt"
+	"math"
 	"net"
 
 	"github.com/containernetworking/plugins/pkg/ns"
@@ -14,6 +15,7 @@ import (
 	"github.com/vishvananda/netlink"
 	"go.podman.io/common/libnetwork/types"
 	"go.podman.io/common/pkg/netns"
+	"golang.org/x/sys/unix"
 )
 
 // Create and configure a new network namespace for a container
@@ -33,7 +35,10 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS string) (status map[strin
 		return nil, r.setupSlirp4netns(ctr, ctrNS)
 	}
 	if ctr.config.NetMode.IsPasta() {
-		return nil, r.setupPasta(ctr, ctrNS)
+		if err := r.setupPasta(ctr, ctrNS); err != nil {
+			return nil, err
+		}
+		return nil, r.setupEgressAllow(ctr, ctrNS, nil)
 	}
 	networks, err := ctr.networks()
 	if err != nil {
@@ -59,6 +64,10 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS string) (status map[strin
 		}
 	}()
 
+	if err := r.setupEgressAllow(ctr, ctrNS, netStatus); err != nil {
+		return nil, err
+	}
+
 	// set up rootless port forwarder when rootless with ports and the network status is empty,
 	// if this is called from network reload the network status will not be empty and we should
 	// not set up port because they are still active
@@ -73,6 +82,66 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS string) (status map[strin
 	return netStatus, err
 }
 
+// setupNetworkQoS applies the QoS to the network interfaces of the network
+// namespace with traffic control.  Sent traffic passes a netem qdisc which
+// limits its rate and adds latency and loss, received traffic is policed.
+func setupNetworkQoS(netns string, qos map[string]*define.NetworkQoS) error {
+	return ns.WithNetNSPath(netns, func(_ ns.NetNS) error {
+		for ifName, netQoS := range qos {
+			link, err := netlink.LinkByName(ifName)
+			if err != nil {
+				return fmt.Errorf("getting network interface %s: %w", ifName, err)
+			}
+			index := link.Attrs().Index
+
+			if netQoS.EgressRate > 0 || netQoS.Latency > 0 || netQoS.Loss > 0 {
+				netem := netlink.NewNetem(netlink.QdiscAttrs{
+					LinkIndex: index,
+					Handle:    netlink.MakeHandle(1, 0),
+					Parent:    netlink.HANDLE_ROOT,
+				}, netlink.NetemQdiscAttrs{
+					Latency: uint32(min(netQoS.Latency.Microseconds(), math.MaxUint32)),
+					Loss:    float32(netQoS.Loss),
+					Rate64:  netQoS.EgressRate,
+				})
+				if err := netlink.QdiscReplace(netem); err != nil {
+					return fmt.Errorf("setting egress QoS of network interface %s: %w", ifName, err)
+				}
+			}
+
+			if netQoS.IngressRate > 0 {
+				ingress := &netlink.Ingress{QdiscAttrs: netlink.QdiscAttrs{
+					LinkIndex: index,
+					Handle:    netlink.MakeHandle(0xffff, 0),
+					Parent:    netlink.HANDLE_INGRESS,
+				}}
+				if err := netlink.QdiscReplace(ingress); err != nil {
+					return fmt.Errorf("setting ingress QoS of network interface %s: %w", ifName, err)
+				}
+				police := netlink.NewPoliceAction()
+				police.Rate = uint32(min(netQoS.IngressRate, math.MaxUint32))
+				// Allow bursts of 100ms of traffic, but at least of
+				// some full sized packets.
+				police.Burst = max(police.Rate/10, 64*1024)
+				police.ExceedAction = netlink.TC_POLICE_SHOT
+				filter := &netlink.MatchAll{
+					FilterAttrs: netlink.FilterAttrs{
+						LinkIndex: index,
+						Parent:    netlink.MakeHandle(0xffff, 0),
+						Priority:  1,
+						Protocol:  unix.ETH_P_ALL,
+					},
+					Actions: []netlink.Action{police},
+				}
+				if err := netlink.FilterReplace(filter); err != nil {
+					return fmt.Errorf("setting ingress QoS of network interface %s: %w", ifName, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
 // Create and configure a new network namespace for a container
 func (r *Runtime) createNetNS(ctr *Container) (n string, q map[string]types.StatusBlock, retErr error) {
 	ctrNS, err := netns.NewNS()
@@ -134,6 +203,7 @@ func (r *Runtime) teardownNetNS(ctr *Container) error {
 	}
 
 	ctr.state.NetNS = ""
+	ctr.state.EgressRules = nil
 
 	return prevErr
 }
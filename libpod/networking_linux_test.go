@@ -4,6 +4,8 @@ package libpod
 
 import (
 	"net"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -212,3 +214,81 @@ func Test_resultToBasicNetworkConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_resolveEgressRules(t *testing.T) {
+	rules, err := resolveEgressRules([]string{"10.0.0.1/8", "192.168.1.5:443", "[fd00::1]:53", "fd00::/8", "localhost:80"})
+	if err != nil {
+		t.Fatalf("resolveEgressRules() failed: %v", err)
+	}
+	expected := []define.EgressRule{
+		{Allow: "10.0.0.1/8", Destinations: []string{"10.0.0.0/8"}},
+		{Allow: "192.168.1.5:443", Destinations: []string{"192.168.1.5"}, Port: 443},
+		{Allow: "[fd00::1]:53", Destinations: []string{"fd00::1"}, Port: 53},
+		{Allow: "fd00::/8", Destinations: []string{"fd00::/8"}},
+	}
+	if !reflect.DeepEqual(expected, rules[:4]) {
+		t.Fatalf("Expected egress rules %+v didn't match actual value %+v", expected, rules[:4])
+	}
+	if rules[4].Port != 80 || len(rules[4].Destinations) == 0 {
+		t.Fatalf("Expected host name to be resolved, got %+v", rules[4])
+	}
+
+	for _, allow := range []string{"", ":80", "10.0.0.1:0", "10.0.0.1:http", "10.0.0.0/33", "[fd00::1", "[fd00::1]80"} {
+		if _, err := resolveEgressRules([]string{allow}); err == nil {
+			t.Fatalf("Expected egress allow entry %q to be invalid", allow)
+		}
+	}
+}
+
+func Test_egressRuleset(t *testing.T) {
+	rules := []define.EgressRule{
+		{Allow: "10.0.0.0/8", Destinations: []string{"10.0.0.0/8"}},
+		{Allow: "registry.local:443", Destinations: []string{"192.168.1.5", "fd00::5"}, Port: 443},
+	}
+	expected := `table inet podman_egress
+delete table inet podman_egress
+table inet podman_egress {
+	chain output {
+		type filter hook output priority filter; policy drop;
+		oifname "lo" accept
+		ct state established,related accept
+		icmpv6 type { nd-router-solicit, nd-neighbor-solicit, nd-neighbor-advert } accept
+		ip daddr 10.89.0.1 tcp dport 53 accept
+		ip daddr 10.89.0.1 udp dport 53 accept
+		ip daddr 10.0.0.0/8 accept
+		ip daddr 192.168.1.5 tcp dport 443 accept
+		ip daddr 192.168.1.5 udp dport 443 accept
+		ip6 daddr fd00::5 tcp dport 443 accept
+		ip6 daddr fd00::5 udp dport 443 accept
+	}
+}
+`
+	if actual := egressRuleset(rules, []string{"10.89.0.1"}); actual != expected {
+		t.Fatalf("Expected ruleset:\n%s\ndidn't match actual ruleset:\n%s", expected, actual)
+	}
+}
+
+func Test_egressDNSServers(t *testing.T) {
+	resolvConf := filepath.Join(t.TempDir(), "resolv.conf")
+	content := "search dns.podman\nnameserver 10.89.0.1\nnameserver 192.168.1.1\nnameserver fe80::1%eth0\noptions edns0\n"
+	if err := os.WriteFile(resolvConf, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	ctr := &Container{
+		config: &ContainerConfig{},
+		state:  &ContainerState{BindMounts: map[string]string{"/etc/resolv.conf": resolvConf}},
+	}
+	status := map[string]types.StatusBlock{
+		"podman1": {DNSServerIPs: []net.IP{net.ParseIP("10.89.0.1")}},
+	}
+
+	// the nameservers of resolv.conf are allowed once, even without a
+	// network DNS server
+	expected := []string{"10.89.0.1", "192.168.1.1"}
+	if actual := egressDNSServers(ctr, status); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected DNS servers %v didn't match actual value %v", expected, actual)
+	}
+	if actual := egressDNSServers(ctr, nil); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected DNS servers %v didn't match actual value %v", expected, actual)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"net/http"
 	"os"
@@ -46,6 +47,10 @@ const (
 	// Important: The conmon attach socket uses an extra byte at the beginning of each
 	// message to specify the STREAM so we have to increase the buffer size by one
 	bufferSize = conmonConfig.BufSize + 1
+
+	// rotatedLogSizeCapFactor is the multiple of the maximum log size at
+	// which conmon truncates a log file which is rotated by Podman.
+	rotatedLogSizeCapFactor = 2
 )
 
 // ConmonOCIRuntime is an OCI runtime managed by Conmon.
@@ -760,11 +765,17 @@ func (r *ConmonOCIRuntime) CheckpointContainer(ctr *Container, options Container
 	if options.PreCheckPoint {
 		args = append(args, "--pre-dump")
 	}
-	if !options.PreCheckPoint && options.WithPrevious {
+	if options.WithPrevious {
+		// A chained pre-dump is based on the previous pre-dump, which
+		// has been moved aside for it.
+		parentDir := preCheckpointDir
+		if options.PreCheckPoint {
+			parentDir = preCheckpointParentDir
+		}
 		args = append(
 			args,
 			"--parent-path",
-			filepath.Join("..", preCheckpointDir),
+			filepath.Join("..", parentDir),
 		)
 	}
 
@@ -1331,17 +1342,18 @@ func (r *ConmonOCIRuntime) sharedConmonArgs(ctr *Container, cuuid, bundlePath, p
 		logDriverArg = define.PassthroughLogging
 	//lint:ignore ST1015 the default case has to be here
 	default: //nolint:gocritic
-		// No case here should happen except JSONLogging, but keep this here in case the options are extended
+		// No case here should happen, but keep this here in case the options are extended
 		logrus.Errorf("%s logging specified but not supported. Choosing k8s-file logging instead", ctr.LogDriver())
 		fallthrough
 	case "":
 		// to get here, either a user would specify `--log-driver ""`, or this came from another place in libpod
 		// since the former case is obscure, and the latter case isn't an error, let's silently fallthrough
 		fallthrough
-	case define.JSONLogging:
-		fallthrough
 	case define.KubernetesLogging:
 		logDriverArg = fmt.Sprintf("%s:%s", define.KubernetesLogging, logPath)
+	case define.JSONLogging:
+		// Podman ingests the captured lines into the log
+		logDriverArg = fmt.Sprintf("%s:%s", define.KubernetesLogging, logs.CaptureLogPath(logPath))
 	}
 
 	args = append(args, "-l", logDriverArg)
@@ -1351,8 +1363,16 @@ func (r *ConmonOCIRuntime) sharedConmonArgs(ctr *Container, cuuid, bundlePath, p
 	logrus.Debugf("%s messages will be logged to syslog", r.conmonPath)
 	args = append(args, "--syslog")
 
+	// Podman rotates log files once they reach their maximum size, conmon
+	// only truncates them as a hard cap if they are not rotated before
+	// reaching a multiple of it.
+	// The capture file of the json-file log driver is not truncated while
+	// its lines are ingested by a timer, they would be lost otherwise.
 	size := ctr.LogSizeMax()
-	if size > 0 {
+	if size > 0 && (ctr.config.LogMaxFiles > 0 || ctr.LogDriver() == define.JSONLogging) {
+		size = min(size, math.MaxInt64/rotatedLogSizeCapFactor) * rotatedLogSizeCapFactor
+	}
+	if size > 0 && !ctr.logIngestTimerEnabled() {
 		args = append(args, "--log-size-max", strconv.FormatInt(size, 10))
 	}
 
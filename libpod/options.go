@@ -431,8 +431,9 @@ func WithRenumber() RuntimeOption {
 }
 
 // WithEventsLogger sets the events backend to use.
-// Currently supported values are "file" for file backend and "journald" for
-// journald backend.
+// Currently supported values are "file" for file backend, "journald" for
+// journald backend, "none", and the unix://, http:// or https:// URL of an
+// endpoint to forward events to.
 func WithEventsLogger(logger string) RuntimeOption {
 	return func(rt *Runtime) error {
 		if rt.valid {
@@ -491,6 +492,30 @@ func WithMaxLogSize(limit int64) CtrCreateOption {
 	}
 }
 
+// WithLogRotation enables the rotation of the container's log file.  The log
+// file is rotated once it reaches its maximum size or holds lines older than
+// maxAge, keeping maxFiles rotated log files which are compressed if compress
+// is set.
+func WithLogRotation(maxFiles uint, maxAge time.Duration, compress bool) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		if maxFiles == 0 {
+			return fmt.Errorf("number of rotated log files must be greater than 0: %w", define.ErrInvalidArg)
+		}
+		if maxAge < 0 {
+			return fmt.Errorf("maximum log age must not be negative: %w", define.ErrInvalidArg)
+		}
+
+		ctr.config.LogMaxFiles = maxFiles
+		ctr.config.LogMaxAge = maxAge
+		ctr.config.LogCompress = compress
+
+		return nil
+	}
+}
+
 // WithShmDir sets the directory that should be mounted on /dev/shm.
 func WithShmDir(dir string) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -957,6 +982,39 @@ func WithDependencyCtrs(ctrs []*Container) CtrCreateOption {
 	}
 }
 
+// WithHealthyDependencyCtrs sets dependency containers of the given container
+// which must report a healthy status before this container is started.
+// The containers are added to the regular dependencies if not already present.
+// timeout is the maximum time to wait for them to become healthy.
+func WithHealthyDependencyCtrs(ctrs []*Container, timeout time.Duration) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		deps := make([]string, 0, len(ctrs))
+
+		for _, dep := range ctrs {
+			if err := checkDependencyContainer(dep, ctr); err != nil {
+				return err
+			}
+			if !dep.HasHealthCheck() {
+				return fmt.Errorf("container %s has no healthcheck and cannot be required to be healthy: %w", dep.ID(), define.ErrInvalidArg)
+			}
+
+			deps = append(deps, dep.ID())
+			if !slices.Contains(ctr.config.Dependencies, dep.ID()) {
+				ctr.config.Dependencies = append(ctr.config.Dependencies, dep.ID())
+			}
+		}
+
+		ctr.config.HealthyDependencies = deps
+		ctr.config.HealthyDependencyTimeout = timeout
+
+		return nil
+	}
+}
+
 // WithNetNS indicates that the container should be given a new network
 // namespace with a minimal configuration.
 // An optional array of port mappings can be provided.
@@ -1475,6 +1533,18 @@ func WithHealthCheckOnFailureAction(action define.HealthCheckOnFailureAction) Ct
 	}
 }
 
+// WithHealthCheckOnFailureHook sets the command or http(s) URL to notify
+// when the notify on-failure action is used.
+func WithHealthCheckOnFailureHook(hook string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.HealthCheckOnFailureHook = hook
+		return nil
+	}
+}
+
 // WithPreserveFDs forwards from the process running Libpod into the container
 // the given number of extra FDs (starting after the standard streams) to the created container
 func WithPreserveFDs(fd uint) CtrCreateOption {
@@ -1729,6 +1799,25 @@ func WithVolumeDisableQuota() VolumeCreateOption {
 	}
 }
 
+// WithVolumeQuotaBackend sets the backend used to enforce the size and inodes
+// limits of the volume.
+func WithVolumeQuotaBackend(backend string) VolumeCreateOption {
+	return func(volume *Volume) error {
+		if volume.valid {
+			return define.ErrVolumeFinalized
+		}
+
+		switch backend {
+		case define.VolumeQuotaBackendAuto, define.VolumeQuotaBackendXFS, define.VolumeQuotaBackendLoop:
+		default:
+			return fmt.Errorf("invalid quota backend %q, must be one of %s, %s or %s: %w", backend, define.VolumeQuotaBackendAuto, define.VolumeQuotaBackendXFS, define.VolumeQuotaBackendLoop, define.ErrInvalidArg)
+		}
+		volume.config.QuotaBackend = backend
+
+		return nil
+	}
+}
+
 // withSetAnon sets a bool notifying libpod that this volume is anonymous and
 // should be removed when containers using it are removed and volumes are
 // specified for removal.
@@ -1744,6 +1833,34 @@ func withSetAnon() VolumeCreateOption {
 	}
 }
 
+// withVolumeSnapshotOf marks the volume as a snapshot of the volume with the
+// given name.
+func withVolumeSnapshotOf(name string) VolumeCreateOption {
+	return func(volume *Volume) error {
+		if volume.valid {
+			return define.ErrVolumeFinalized
+		}
+
+		volume.config.SnapshotOf = name
+
+		return nil
+	}
+}
+
+// withVolumeClonedFrom marks the volume as a clone of the volume with the
+// given name.
+func withVolumeClonedFrom(name string) VolumeCreateOption {
+	return func(volume *Volume) error {
+		if volume.valid {
+			return define.ErrVolumeFinalized
+		}
+
+		volume.config.ClonedFrom = name
+
+		return nil
+	}
+}
+
 // WithVolumeDriverTimeout sets the volume creation timeout period.
 // Only usable if a non-local volume driver is in use.
 func WithVolumeDriverTimeout(timeout uint) VolumeCreateOption {
@@ -2260,6 +2377,26 @@ func WithBaseHostsFile(baseHostsFile string) CtrCreateOption {
 	}
 }
 
+// WithEgressAllow sets the egress allow list of the container.  The
+// container may only send traffic to the given destinations, which are
+// enforced with nftables rules in the network namespace of the container.
+func WithEgressAllow(allow []string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		for _, entry := range allow {
+			if _, _, err := define.ParseEgressAllow(entry); err != nil {
+				return fmt.Errorf("%v: %w", err, define.ErrInvalidArg)
+			}
+		}
+		ctr.config.EgressAllow = allow
+
+		return nil
+	}
+}
+
 // WithMountAllDevices sets the option to mount all of a privileged container's
 // host devices
 func WithMountAllDevices() CtrCreateOption {
@@ -6,12 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"path/filepath"
 	"slices"
 	"sort"
 	"strings"
 
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
+	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/parallel"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
@@ -109,7 +112,7 @@ func (p *Pod) Start(ctx context.Context) (map[string]error, error) {
 
 	// Traverse the graph beginning at nodes with no dependencies
 	for _, node := range graph.noDepNodes {
-		startNode(ctx, node, false, ctrErrors, ctrsVisited, false)
+		startNode(ctx, node, false, ctrErrors, ctrsVisited, false, p.lock)
 	}
 
 	if len(ctrErrors) > 0 {
@@ -481,7 +484,7 @@ func (p *Pod) Restart(ctx context.Context) (map[string]error, error) {
 
 	// Traverse the graph beginning at nodes with no dependencies
 	for _, node := range graph.noDepNodes {
-		startNode(ctx, node, false, ctrErrors, ctrsVisited, true)
+		startNode(ctx, node, false, ctrErrors, ctrsVisited, true, p.lock)
 	}
 
 	if len(ctrErrors) > 0 {
@@ -492,6 +495,205 @@ func (p *Pod) Restart(ctx context.Context) (map[string]error, error) {
 	return nil, nil
 }
 
+// Update updates the resource limits, restart policy and labels of the pod.
+// Resource limits are applied to the pod cgroup immediately, so they take
+// effect for running containers.  The restart policy is applied to the infra
+// container and to all containers using the pod's restart policy; containers
+// created with their own restart policy keep it.
+// Updates are stored in the pod's configuration, so they persist when the pod
+// is restarted.
+func (p *Pod) Update(options *entities.PodUpdateOptions) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return define.ErrPodRemoved
+	}
+	if err := p.updatePod(); err != nil {
+		return err
+	}
+
+	if options.RestartPolicy != nil {
+		if err := define.ValidateRestartPolicy(*options.RestartPolicy); err != nil {
+			return err
+		}
+		if options.RestartRetries != nil && *options.RestartPolicy != define.RestartPolicyOnFailure {
+			return fmt.Errorf("cannot set restart policy retries unless policy is on-failure: %w", define.ErrInvalidArg)
+		}
+	} else if options.RestartRetries != nil {
+		return fmt.Errorf("must provide restart policy if updating restart retries: %w", define.ErrInvalidArg)
+	}
+
+	newConfig := new(PodConfig)
+	if err := JSONDeepCopy(p.config, newConfig); err != nil {
+		return err
+	}
+
+	if options.Resources != nil {
+		if !p.config.UsePodCgroup {
+			return fmt.Errorf("pod %s does not have a cgroup, cannot update resources: %w", p.ID(), define.ErrNoCgroups)
+		}
+		resources, err := json.Marshal(options.Resources)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(resources, &newConfig.ResourceLimits); err != nil {
+			return err
+		}
+		if err := p.platformUpdateCgroup(&newConfig.ResourceLimits); err != nil {
+			return fmt.Errorf("updating cgroup of pod %s: %w", p.ID(), err)
+		}
+	}
+
+	if len(options.Labels) > 0 || len(options.UnsetLabels) > 0 {
+		if newConfig.Labels == nil {
+			newConfig.Labels = make(map[string]string)
+		}
+		maps.Copy(newConfig.Labels, options.Labels)
+		for _, label := range options.UnsetLabels {
+			delete(newConfig.Labels, label)
+		}
+	}
+
+	oldRestartPolicy := p.config.RestartPolicy
+	if options.RestartPolicy != nil {
+		newConfig.RestartPolicy = *options.RestartPolicy
+		newConfig.RestartRetries = options.RestartRetries
+	}
+
+	if err := p.runtime.state.RewritePodConfig(p, newConfig); err != nil {
+		return err
+	}
+	p.config = newConfig
+	defer p.newPodEvent(events.Update)
+
+	if options.RestartPolicy == nil {
+		return nil
+	}
+	allCtrs, err := p.runtime.state.PodContainers(p)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, ctr := range allCtrs {
+		if ctr.ID() != p.state.InfraContainerID && ctr.RestartPolicy() != oldRestartPolicy {
+			continue
+		}
+		ctrOptions := &entities.ContainerUpdateOptions{
+			ChangedHealthCheckConfiguration: &define.UpdateHealthCheckConfig{},
+			RestartPolicy:                   options.RestartPolicy,
+			RestartRetries:                  options.RestartRetries,
+		}
+		if err := ctr.Update(ctrOptions); err != nil {
+			errs = append(errs, fmt.Errorf("updating restart policy of container %s: %w", ctr.ID(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Checkpoint checkpoints all running and paused containers of the pod except
+// for its infra container and exports the checkpoint of each container to a
+// file named after the container's ID in dir.  All containers are paused
+// before the first one is checkpointed, so the checkpoints reflect the pod at
+// a single point in time, and no container is stopped unless all of them have
+// been checkpointed.  Unless options.KeepRunning is set, the pod is stopped
+// afterwards.  Otherwise containers which were paused before are left paused.
+// The pod lock is not held while checkpointing, as exporting the checkpoint
+// of a container in a pod requires it.
+// The IDs of the checkpointed containers are returned.
+func (p *Pod) Checkpoint(ctx context.Context, dir string, options ContainerCheckpointOptions) ([]string, error) {
+	ctrs, err := func() ([]*Container, error) {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		if !p.valid {
+			return nil, define.ErrPodRemoved
+		}
+		allCtrs, err := p.runtime.state.PodContainers(p)
+		if err != nil {
+			return nil, err
+		}
+		infraID, err := p.infraContainerID()
+		if err != nil {
+			return nil, err
+		}
+		var ctrs []*Container
+		for _, ctr := range allCtrs {
+			if ctr.ID() == infraID {
+				continue
+			}
+			state, err := ctr.State()
+			if err != nil {
+				return nil, err
+			}
+			if state == define.ContainerStateRunning || state == define.ContainerStatePaused {
+				ctrs = append(ctrs, ctr)
+			}
+		}
+		return ctrs, nil
+	}()
+	if err != nil {
+		return nil, err
+	}
+	if len(ctrs) == 0 {
+		return nil, fmt.Errorf("pod %s has no running containers to checkpoint: %w", p.ID(), define.ErrCtrStateInvalid)
+	}
+
+	// paused holds the containers paused by Checkpoint, which are unpaused
+	// again unless they have been killed.
+	var paused []*Container
+	defer func() {
+		for _, ctr := range paused {
+			if err := ctr.Unpause(); err != nil {
+				logrus.Errorf("Unpausing container %s after checkpointing pod %s: %v", ctr.ID(), p.ID(), err)
+			}
+		}
+	}()
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil {
+			return nil, err
+		}
+		if state == define.ContainerStatePaused {
+			continue
+		}
+		if err := ctr.Pause(); err != nil {
+			return nil, fmt.Errorf("pausing container %s: %w", ctr.ID(), err)
+		}
+		paused = append(paused, ctr)
+	}
+
+	ids := make([]string, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		ctrOptions := options
+		ctrOptions.KeepRunning = true
+		ctrOptions.TargetFile = filepath.Join(dir, ctr.ID()+".tar")
+		if _, _, err := ctr.Checkpoint(ctx, ctrOptions); err != nil {
+			return nil, fmt.Errorf("checkpointing container %s: %w", ctr.ID(), err)
+		}
+		ids = append(ids, ctr.ID())
+	}
+	defer p.newPodEvent(events.Checkpoint)
+
+	if options.KeepRunning {
+		return ids, nil
+	}
+
+	// Kill the containers while they are still paused, so their state
+	// cannot change after they have been checkpointed.  killPaused thaws
+	// each container, so it must not be unpaused again.
+	for _, ctr := range ctrs {
+		paused = slices.DeleteFunc(paused, func(c *Container) bool { return c == ctr })
+		if err := ctr.killPaused(); err != nil {
+			return nil, fmt.Errorf("stopping checkpointed container %s: %w", ctr.ID(), err)
+		}
+	}
+	if _, err := p.StopWithTimeout(ctx, true, 0); err != nil {
+		return nil, fmt.Errorf("stopping pod %s: %w", p.ID(), err)
+	}
+	return ids, nil
+}
+
 // Kill sends a signal to all running containers within a pod.
 // Signals will only be sent to running containers. Containers that are not
 // running will be ignored. All signals are sent independently, and sending will
@@ -42,6 +42,7 @@ import (
 	is "go.podman.io/image/v5/storage"
 	"go.podman.io/image/v5/types"
 	"go.podman.io/storage"
+	"go.podman.io/storage/drivers/quota"
 	"go.podman.io/storage/pkg/fileutils"
 	"go.podman.io/storage/pkg/lockfile"
 	"go.podman.io/storage/pkg/unshare"
@@ -85,6 +86,12 @@ type Runtime struct {
 	// ArtifactStore returns the artifact store created from the runtime.
 	ArtifactStore func() (*artStore.ArtifactStore, error)
 
+	// volumeQuota is the project quota control of the volume path the
+	// usage of volumes is read with, see volumeQuotaControl.
+	volumeQuota        *quota.Control
+	volumeQuotaCreated time.Time
+	volumeQuotaLock    sync.Mutex
+
 	// Worker
 	workerChannel chan func()
 	workerGroup   sync.WaitGroup
@@ -780,6 +787,11 @@ func (r *Runtime) Shutdown(force bool) error {
 			lastError = fmt.Errorf("shutting down container storage: %w", err)
 		}
 	}
+	// Give the events being forwarded a chance to be delivered.
+	if forwarder, ok := r.eventer.(*events.EventForwarder); ok {
+		forwarder.Wait()
+	}
+
 	if err := r.state.Close(); err != nil {
 		if lastError != nil {
 			logrus.Error(lastError)
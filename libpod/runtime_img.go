@@ -3,9 +3,12 @@
 package libpod
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	buildahDefine "github.com/containers/buildah/define"
 	"github.com/containers/buildah/imagebuildah"
@@ -14,6 +17,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.podman.io/common/libimage"
 	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/storage"
 )
 
 // Runtime API
@@ -103,6 +107,71 @@ func (r *Runtime) IsExternalContainerCallback(_ context.Context) libimage.IsExte
 	}
 }
 
+// ImageLayerUsage returns the layers of all images together with the images
+// using them, sorted by size.  Layers which are only used by containers are
+// not included.
+func (r *Runtime) ImageLayerUsage() ([]define.ImageLayerUsage, error) {
+	layers, err := r.store.Layers()
+	if err != nil {
+		return nil, err
+	}
+	images, err := r.store.Images()
+	if err != nil {
+		return nil, err
+	}
+
+	layerMap := make(map[string]*storage.Layer, len(layers))
+	for i := range layers {
+		layerMap[layers[i].ID] = &layers[i]
+	}
+
+	usage := make(map[string]*define.ImageLayerUsage)
+	for _, image := range images {
+		// Walk all layer chains of the image, there is one per ID
+		// mapping in addition to the top layer.
+		visited := make(map[string]struct{})
+		for _, layerID := range append([]string{image.TopLayer}, image.MappedTopLayers...) {
+			for layerID != "" {
+				if _, ok := visited[layerID]; ok {
+					break
+				}
+				visited[layerID] = struct{}{}
+				layer, ok := layerMap[layerID]
+				if !ok {
+					logrus.Errorf("Layer %s of image %s is missing from the storage", layerID, image.ID)
+					break
+				}
+				u, ok := usage[layerID]
+				if !ok {
+					size := layer.UncompressedSize
+					if size == -1 {
+						size, err = r.store.DiffSize("", layer.ID)
+						if err != nil {
+							return nil, err
+						}
+					}
+					u = &define.ImageLayerUsage{ID: layer.ID, Size: size}
+					usage[layerID] = u
+				}
+				u.Images = append(u.Images, image.ID)
+				layerID = layer.Parent
+			}
+		}
+	}
+
+	result := make([]define.ImageLayerUsage, 0, len(usage))
+	for _, u := range usage {
+		result = append(result, *u)
+	}
+	slices.SortFunc(result, func(a, b define.ImageLayerUsage) int {
+		if c := cmp.Compare(b.Size, a.Size); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return result, nil
+}
+
 // newImageBuildCompleteEvent creates a new event based on completion of a built image
 func (r *Runtime) newImageBuildCompleteEvent(idOrName string) {
 	e := events.NewEvent(events.Build)
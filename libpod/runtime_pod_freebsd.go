@@ -10,6 +10,10 @@ func (r *Runtime) platformMakePod(_ *Pod, _ *spec.LinuxResources) (string, error
 	return "", nil
 }
 
+func (p *Pod) platformUpdateCgroup(_ *spec.LinuxResources) error {
+	return nil
+}
+
 func (p *Pod) removePodCgroup() error {
 	return nil
 }
@@ -89,6 +89,27 @@ func (r *Runtime) platformMakePod(pod *Pod, resourceLimits *spec.LinuxResources)
 	return cgroupParent, nil
 }
 
+// platformUpdateCgroup applies the resource limits to the pod cgroup.  A
+// cgroup which does not exist yet gets the limits when it is created.
+func (p *Pod) platformUpdateCgroup(resourceLimits *spec.LinuxResources) error {
+	if p.state.CgroupPath == "" {
+		return fmt.Errorf("pod %s does not have a cgroup: %w", p.ID(), define.ErrNoCgroups)
+	}
+	if !cgroupExist(p.state.CgroupPath) {
+		return nil
+	}
+	res, err := GetLimits(resourceLimits)
+	if err != nil {
+		return err
+	}
+	res.SkipDevices = true
+	cgroup, err := cgroups.Load(p.state.CgroupPath)
+	if err != nil {
+		return err
+	}
+	return cgroup.Update(&res)
+}
+
 func (p *Pod) removePodCgroup() error {
 	// Remove pod cgroup, if present
 	if p.state.CgroupPath == "" {
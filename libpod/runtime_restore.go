@@ -0,0 +1,83 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/sirupsen/logrus"
+)
+
+// RestorePod recreates a pod from the configuration of a pod backed up on
+// another host.  The pod keeps its ID and name but is assigned a new lock.
+// Its infra container must be restored with RestoreContainer and added to
+// the pod with AddInfra afterwards.
+func (r *Runtime) RestorePod(_ context.Context, config *PodConfig) (_ *Pod, retErr error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	pod := newPod(r)
+	if err := JSONDeepCopy(config, pod.config); err != nil {
+		return nil, fmt.Errorf("copying pod config for restore: %w", err)
+	}
+
+	lock, err := r.lockManager.AllocateLock()
+	if err != nil {
+		return nil, fmt.Errorf("allocating lock for restored pod: %w", err)
+	}
+	pod.lock = lock
+	pod.config.LockID = pod.lock.ID()
+	defer func() {
+		if retErr != nil {
+			if err := pod.lock.Free(); err != nil {
+				logrus.Errorf("Freeing pod lock after failed restore: %v", err)
+			}
+		}
+	}()
+
+	pod.valid = true
+
+	if _, err := r.platformMakePod(pod, &pod.config.ResourceLimits); err != nil {
+		return nil, err
+	}
+	if err := r.state.AddPod(pod); err != nil {
+		return nil, fmt.Errorf("adding pod to state: %w", err)
+	}
+	return pod, nil
+}
+
+// RestoreVolume recreates a volume from the configuration of a volume backed
+// up on another host.  The volume is assigned a new lock.  Volumes of the
+// local driver are created empty and are not chowned on first use, as their
+// data including its ownership is expected to be imported.
+func (r *Runtime) RestoreVolume(ctx context.Context, config *VolumeConfig) (*Volume, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	options := []VolumeCreateOption{
+		WithVolumeName(config.Name),
+		WithVolumeDriver(config.Driver),
+		WithVolumeLabels(config.Labels),
+		WithVolumeOptions(config.Options),
+		WithVolumeUID(config.UID),
+		WithVolumeGID(config.GID),
+		WithVolumeSize(config.Size),
+		WithVolumeInodes(config.Inodes),
+		WithVolumeMountLabel(config.MountLabel),
+		WithVolumeNoChown(),
+	}
+	if config.IsAnon {
+		options = append(options, withSetAnon())
+	}
+	if config.DisableQuota {
+		options = append(options, WithVolumeDisableQuota())
+	}
+	if config.Timeout != nil {
+		options = append(options, WithVolumeDriverTimeout(*config.Timeout))
+	}
+	return r.newVolume(ctx, false, options...)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
 	volplugin "github.com/containers/podman/v6/libpod/plugin"
+	"github.com/containers/podman/v6/pkg/rootless"
 	pluginapi "github.com/docker/go-plugins-helpers/volume"
 	"github.com/opencontainers/selinux/go-selinux"
 	"github.com/sirupsen/logrus"
@@ -178,31 +179,27 @@ func (r *Runtime) newVolume(ctx context.Context, noCreatePluginVolume bool, opti
 			if volume.config.Size > 0 || volume.config.Inodes > 0 {
 				return nil, errors.New("volume options size and inodes cannot be used without quota")
 			}
+			if volume.config.QuotaBackend != "" {
+				return nil, errors.New("volume option quota-backend cannot be used without quota")
+			}
 		case volume.config.Options["type"] == define.TypeTmpfs:
 			// tmpfs only supports Size
 			if volume.config.Inodes > 0 {
 				return nil, errors.New("volume option inodes not supported on tmpfs filesystem")
 			}
-		case volume.config.Inodes > 0 || volume.config.Size > 0:
-			projectQuotaSupported := false
-			q, err := quota.NewControl(r.config.Engine.VolumePath)
-			if err == nil {
-				projectQuotaSupported = true
-			}
-			if !projectQuotaSupported {
-				return nil, errors.New("volume options size and inodes not supported. Filesystem does not support Project Quota")
+			if volume.config.QuotaBackend != "" && volume.config.QuotaBackend != define.VolumeQuotaBackendAuto {
+				return nil, errors.New("volume option quota-backend not supported on tmpfs filesystem")
 			}
-			quota := quota.Quota{
-				Inodes: volume.config.Inodes,
-				Size:   volume.config.Size,
+			volume.config.QuotaBackend = ""
+			if volume.config.Size > 0 {
+				volume.config.QuotaBackend = define.VolumeQuotaBackendTmpfs
 			}
-			// Must use volPathRoot not fullVolPath, as we need the
-			// base path for the volume - without the `_data`
-			// subdirectory - so the quota ID assignment logic works
-			// properly.
-			if err := q.SetQuota(volPathRoot, quota); err != nil {
-				return nil, fmt.Errorf("failed to set size quota size=%d inodes=%d for volume directory %q: %w", volume.config.Size, volume.config.Inodes, volPathRoot, err)
+		case volume.config.Inodes > 0 || volume.config.Size > 0:
+			if err := r.setVolumeQuota(volume, volPathRoot); err != nil {
+				return nil, err
 			}
+		case volume.config.QuotaBackend != "":
+			return nil, errors.New("volume option quota-backend requires the size or inodes option")
 		}
 
 		fullVolPath := filepath.Join(volPathRoot, "_data")
@@ -243,6 +240,69 @@ func (r *Runtime) newVolume(ctx context.Context, noCreatePluginVolume bool, opti
 	return volume, nil
 }
 
+// setVolumeQuota limits the size and inodes of the volume at volPathRoot with
+// the requested quota backend. If no backend was requested, XFS project quota
+// is used when the volume path supports it and a loop device otherwise.
+func (r *Runtime) setVolumeQuota(volume *Volume, volPathRoot string) error {
+	// Setting a project quota and mounting a loop device both require
+	// root privileges.
+	if rootless.IsRootless() {
+		return errors.New("volume options size and inodes require root privileges, only the size of tmpfs volumes can be limited by rootless users")
+	}
+	q, quotaErr := quota.NewControl(r.config.Engine.VolumePath)
+
+	backend := volume.config.QuotaBackend
+	if backend == "" || backend == define.VolumeQuotaBackendAuto {
+		if quotaErr == nil {
+			backend = define.VolumeQuotaBackendXFS
+		} else {
+			// Without project quota, only the size of a volume
+			// can be limited, by a loop device.
+			if volume.config.Size == 0 {
+				return fmt.Errorf("volume option inodes without size not supported. Filesystem does not support Project Quota: %w", quotaErr)
+			}
+			if err := r.checkLoopVolumeSupport(); err != nil {
+				return fmt.Errorf("volume options size and inodes not supported. Filesystem does not support Project Quota (%w) and %w", quotaErr, err)
+			}
+			logrus.Debugf("Project quota not supported, using a loop device to limit the size of volume %s", volume.config.Name)
+			backend = define.VolumeQuotaBackendLoop
+		}
+	}
+
+	switch backend {
+	case define.VolumeQuotaBackendXFS:
+		if quotaErr != nil {
+			return fmt.Errorf("volume option quota-backend=xfs not supported. Filesystem does not support Project Quota: %w", quotaErr)
+		}
+		quota := quota.Quota{
+			Inodes: volume.config.Inodes,
+			Size:   volume.config.Size,
+		}
+		// Must use volPathRoot not fullVolPath, as we need the
+		// base path for the volume - without the `_data`
+		// subdirectory - so the quota ID assignment logic works
+		// properly.
+		if err := q.SetQuota(volPathRoot, quota); err != nil {
+			return fmt.Errorf("failed to set size quota size=%d inodes=%d for volume directory %q: %w", volume.config.Size, volume.config.Inodes, volPathRoot, err)
+		}
+	case define.VolumeQuotaBackendLoop:
+		if volume.config.Size == 0 {
+			return errors.New("volume option quota-backend=loop requires the size option")
+		}
+		if _, ok := volume.config.Options["device"]; ok {
+			return errors.New("volume option quota-backend=loop cannot be used with the device option")
+		}
+		if _, ok := volume.config.Options["type"]; ok {
+			return errors.New("volume option quota-backend=loop cannot be used with the type option")
+		}
+		if err := r.createLoopVolumeImage(filepath.Join(volPathRoot, loopVolumeImage), volume.config); err != nil {
+			return fmt.Errorf("creating image for volume %s: %w", volume.config.Name, err)
+		}
+	}
+	volume.config.QuotaBackend = backend
+	return nil
+}
+
 // UpdateVolumePlugins reads all volumes from all configured volume plugins and
 // imports them into the libpod db. It also checks if existing libpod volumes
 // are removed in the plugin, in this case we try to remove it from libpod.
@@ -0,0 +1,361 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/pidhandle"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/storage/drivers/copy"
+)
+
+// SnapshotVolume creates a new volume with a point-in-time copy of the
+// contents of the volume v, which can later be restored with RollbackVolume.
+// If name is empty, it is generated from the name of v and the current time.
+// Unless force is set, the volume must not be in use by a running container,
+// which could change the contents while they are copied.
+func (r *Runtime) SnapshotVolume(ctx context.Context, v *Volume, name string, force bool) (*Volume, error) {
+	if name == "" {
+		name = fmt.Sprintf("%s-snapshot-%s", v.Name(), time.Now().Format("20060102150405"))
+	}
+	return r.copyVolume(ctx, v, force, WithVolumeName(name), withVolumeSnapshotOf(v.Name()))
+}
+
+// CloneVolume creates the new volume name with a copy of the contents of the
+// volume v.
+// Unless force is set, the volume must not be in use by a running container,
+// which could change the contents while they are copied.
+func (r *Runtime) CloneVolume(ctx context.Context, v *Volume, name string, force bool) (*Volume, error) {
+	if name == "" {
+		return nil, fmt.Errorf("must provide a name for the clone of volume %s: %w", v.Name(), define.ErrInvalidArg)
+	}
+	return r.copyVolume(ctx, v, force, WithVolumeName(name), withVolumeClonedFrom(v.Name()))
+}
+
+// RollbackVolume replaces the contents of the volume v with the contents of
+// snapshot, which must be a snapshot of v. If snapshot is nil, the most recent
+// snapshot of v is used. The snapshot which was restored is returned.
+// The volume must not be in use by a running container, containers using it
+// cannot be started until the rollback is done.
+func (r *Runtime) RollbackVolume(ctx context.Context, v *Volume, snapshot *Volume) (_ *Volume, deferredErr error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	if snapshot == nil {
+		snapshots, err := r.volumeSnapshots(v.Name())
+		if err != nil {
+			return nil, err
+		}
+		if len(snapshots) == 0 {
+			return nil, fmt.Errorf("volume %s has no snapshots: %w", v.Name(), define.ErrNoSuchVolume)
+		}
+		snapshot = snapshots[len(snapshots)-1]
+	}
+	if snapshot.config.SnapshotOf != v.Name() {
+		return nil, fmt.Errorf("volume %s is not a snapshot of volume %s: %w", snapshot.Name(), v.Name(), define.ErrInvalidArg)
+	}
+	if err := v.checkCopyable(); err != nil {
+		return nil, err
+	}
+
+	// Containers must be locked before the volume, so the volume is only
+	// marked while its users are checked and its contents are replaced.
+	if err := v.beginRollback(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := v.endRollback(); err != nil {
+			if deferredErr == nil {
+				deferredErr = err
+			} else {
+				logrus.Errorf("Ending rollback of volume %s: %v", v.Name(), err)
+			}
+		}
+	}()
+
+	if err := r.checkVolumeNotRunning(v); err != nil {
+		return nil, err
+	}
+
+	if err := replaceVolumeContents(snapshot, v); err != nil {
+		return nil, fmt.Errorf("restoring volume %s from snapshot %s: %w", v.Name(), snapshot.Name(), err)
+	}
+	logrus.Debugf("Rolled back volume %s to snapshot %s", v.Name(), snapshot.Name())
+	return snapshot, nil
+}
+
+// copyVolume creates a new volume with the configuration of src and copies
+// the contents of src into it.
+func (r *Runtime) copyVolume(ctx context.Context, src *Volume, force bool, options ...VolumeCreateOption) (_ *Volume, deferredErr error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+	if err := src.checkCopyable(); err != nil {
+		return nil, err
+	}
+	if !force {
+		if err := r.checkVolumeNotRunning(src); err != nil {
+			return nil, err
+		}
+	}
+
+	options = append(options,
+		WithVolumeDriver(define.VolumeDriverLocal),
+		WithVolumeLabels(src.config.Labels),
+		WithVolumeOptions(src.config.Options),
+		WithVolumeUID(src.config.UID),
+		WithVolumeGID(src.config.GID),
+		WithVolumeSize(src.config.Size),
+		WithVolumeInodes(src.config.Inodes),
+		WithVolumeMountLabel(src.config.MountLabel),
+	)
+	if src.config.DisableQuota {
+		options = append(options, WithVolumeDisableQuota())
+	}
+	if src.config.QuotaBackend != "" {
+		options = append(options, WithVolumeQuotaBackend(src.config.QuotaBackend))
+	}
+
+	dst, err := r.newVolume(ctx, false, options...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if deferredErr != nil {
+			if err := r.removeVolume(ctx, dst, true, nil, false); err != nil {
+				logrus.Errorf("Removing volume %s after failed copy: %v", dst.Name(), err)
+			}
+		}
+	}()
+
+	if err := copyVolumeContents(src, dst); err != nil {
+		return nil, fmt.Errorf("copying volume %s to %s: %w", src.Name(), dst.Name(), err)
+	}
+	return dst, nil
+}
+
+// checkVolumeNotRunning returns an error if the volume is used by a running
+// or paused container.
+func (r *Runtime) checkVolumeNotRunning(v *Volume) error {
+	ctrIDs, err := v.VolumeInUse()
+	if err != nil {
+		return err
+	}
+	for _, id := range ctrIDs {
+		ctr, err := r.state.Container(id)
+		if err != nil {
+			return err
+		}
+		state, err := ctr.State()
+		if err != nil {
+			return err
+		}
+		if state == define.ContainerStateRunning || state == define.ContainerStatePaused {
+			return fmt.Errorf("volume %s is being used by running container %s: %w", v.Name(), ctr.ID(), define.ErrVolumeBeingUsed)
+		}
+	}
+	return nil
+}
+
+// copyVolumeContents copies the contents of the volume src into the empty
+// volume dst. Files are cloned with reflinks if the filesystem supports
+// them, and copied otherwise.
+// The volumes must not be locked.
+func copyVolumeContents(src, dst *Volume) error {
+	srcPath, unmountSrc, err := src.mountForCopy()
+	if err != nil {
+		return err
+	}
+	defer unmountSrc()
+
+	dstPath, unmountDst, err := dst.mountForCopy()
+	if err != nil {
+		return err
+	}
+	defer unmountDst()
+
+	if err := copy.DirCopy(srcPath, dstPath, copy.Content, true); err != nil {
+		return err
+	}
+	return copyVolumeState(src, dst)
+}
+
+// replaceVolumeContents replaces the contents of the volume dst with a copy
+// of the contents of the volume src.
+// The volumes must not be locked.
+func replaceVolumeContents(src, dst *Volume) error {
+	srcPath, unmountSrc, err := src.mountForCopy()
+	if err != nil {
+		return err
+	}
+	defer unmountSrc()
+
+	dstPath, unmountDst, err := dst.mountForCopy()
+	if err != nil {
+		return err
+	}
+	defer unmountDst()
+
+	if err := replaceDirContents(srcPath, dstPath); err != nil {
+		return err
+	}
+	return copyVolumeState(src, dst)
+}
+
+// replaceDirContents replaces the contents of the directory dstPath with a
+// copy of the contents of srcPath. The contents are removed before copying,
+// so a volume limited by a quota has room for the copy. The copy is restored
+// from a snapshot, which is not changed, so if copying fails, the rollback
+// can be retried.
+func replaceDirContents(srcPath, dstPath string) error {
+	entries, err := os.ReadDir(dstPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(dstPath, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return copy.DirCopy(srcPath, dstPath, copy.Content, true)
+}
+
+// copyVolumeState marks the volume dst, which was populated with a copy of
+// the volume src, as copied up and chowned like src.
+func copyVolumeState(src, dst *Volume) error {
+	// The copy was populated and chowned like the original, keep the
+	// original from being copied up or chowned again.
+	src.lock.Lock()
+	if err := src.update(); err != nil {
+		src.lock.Unlock()
+		return err
+	}
+	state := *src.state
+	src.lock.Unlock()
+
+	dst.lock.Lock()
+	defer dst.lock.Unlock()
+	if err := dst.update(); err != nil {
+		return err
+	}
+	dst.state.NeedsCopyUp = state.NeedsCopyUp
+	dst.state.NeedsChown = state.NeedsChown
+	dst.state.UIDChowned = state.UIDChowned
+	dst.state.GIDChowned = state.GIDChowned
+	return dst.save()
+}
+
+// beginRollback marks the volume as being rolled back by this process, so
+// containers using it cannot be started.
+func (v *Volume) beginRollback() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.update(); err != nil {
+		return err
+	}
+	rollingBack, err := v.rollingBack()
+	if err != nil {
+		return err
+	}
+	if rollingBack {
+		return fmt.Errorf("volume %s is already being rolled back by process %d: %w", v.Name(), v.state.RollbackPID, define.ErrVolumeBeingUsed)
+	}
+	v.state.RollbackPID = os.Getpid()
+	v.state.RollbackPIDData = getPidData(v.state.RollbackPID)
+	return v.save()
+}
+
+// endRollback clears the mark set by beginRollback.
+func (v *Volume) endRollback() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.update(); err != nil {
+		return err
+	}
+	v.state.RollbackPID = 0
+	v.state.RollbackPIDData = ""
+	return v.save()
+}
+
+// rollingBack returns whether the volume is being rolled back by a process
+// which is still running. The process is identified by its PID data, so a
+// reused PID is not mistaken for it.
+// The volume must be locked and updated.
+func (v *Volume) rollingBack() (bool, error) {
+	if v.state.RollbackPID == 0 {
+		return false, nil
+	}
+	pidHandle, err := pidhandle.NewPIDHandleFromString(v.state.RollbackPID, v.state.RollbackPIDData)
+	if err != nil {
+		return false, fmt.Errorf("getting the PID handle for pid %d from '%s': %w", v.state.RollbackPID, v.state.RollbackPIDData, err)
+	}
+	defer pidHandle.Close()
+	alive, err := pidHandle.IsAlive()
+	if err != nil {
+		return false, fmt.Errorf("getting the process status for pid %d: %w", v.state.RollbackPID, err)
+	}
+	return alive, nil
+}
+
+// mountForCopy mounts the volume and returns its mount point, and a function
+// unmounting it again.
+func (v *Volume) mountForCopy() (string, func(), error) {
+	v.lock.Lock()
+	err := v.mount()
+	mountPoint := v.mountPoint()
+	v.lock.Unlock()
+	if err != nil {
+		return "", nil, err
+	}
+	return mountPoint, func() {
+		v.lock.Lock()
+		defer v.lock.Unlock()
+
+		if err := v.unmount(false); err != nil {
+			logrus.Errorf("Error unmounting volume %s: %v", v.Name(), err)
+		}
+	}, nil
+}
+
+// checkCopyable returns an error if the contents of the volume cannot be
+// copied by a snapshot, clone or rollback.
+func (v *Volume) checkCopyable() error {
+	if v.UsesVolumeDriver() || v.config.Driver == define.VolumeDriverImage {
+		return fmt.Errorf("volume %s uses the %s driver, only volumes of the local driver can be copied: %w", v.Name(), v.Driver(), define.ErrNotImplemented)
+	}
+	for _, option := range []string{"device", "type"} {
+		if _, ok := v.config.Options[option]; ok {
+			return fmt.Errorf("volume %s mounts a filesystem with the %s option and cannot be copied: %w", v.Name(), option, define.ErrNotImplemented)
+		}
+	}
+	return nil
+}
+
+// volumeSnapshots returns the snapshots of the volume with the given name,
+// oldest first.
+func (r *Runtime) volumeSnapshots(name string) ([]*Volume, error) {
+	volumes, err := r.state.AllVolumes()
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []*Volume
+	for _, vol := range volumes {
+		if vol.config.SnapshotOf == name {
+			snapshots = append(snapshots, vol)
+		}
+	}
+	slices.SortFunc(snapshots, func(a, b *Volume) int {
+		return a.config.CreatedTime.Compare(b.config.CreatedTime)
+	})
+	return snapshots, nil
+}
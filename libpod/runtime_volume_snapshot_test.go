@@ -0,0 +1,61 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestVolumeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func readTestVolumeFiles(t *testing.T, dir string) map[string]string {
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		files[rel] = string(content)
+		return err
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestReplaceDirContents(t *testing.T) {
+	snapshot := map[string]string{"a": "snapshot", "dir/b": "snapshot"}
+
+	tmpDir := t.TempDir()
+	srcPath := filepath.Join(tmpDir, "src", "_data")
+	dstPath := filepath.Join(tmpDir, "dst", "_data")
+	writeTestVolumeFiles(t, srcPath, snapshot)
+	writeTestVolumeFiles(t, dstPath, map[string]string{"a": "current", "c": "current", "dir/d": "current"})
+
+	require.NoError(t, replaceDirContents(srcPath, dstPath))
+	assert.Equal(t, snapshot, readTestVolumeFiles(t, dstPath), "rolled back contents")
+	// the contents are replaced in place, nothing is staged next to them
+	entries, err := os.ReadDir(filepath.Dir(dstPath))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "volume directory")
+
+	// the rollback can be retried after a failed copy
+	err = replaceDirContents(filepath.Join(tmpDir, "missing"), dstPath)
+	assert.Error(t, err)
+	require.NoError(t, replaceDirContents(srcPath, dstPath))
+	assert.Equal(t, snapshot, readTestVolumeFiles(t, dstPath), "contents after retried rollback")
+}
@@ -0,0 +1,95 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go.podman.io/storage/pkg/ioutils"
+	"go.podman.io/storage/pkg/lockfile"
+)
+
+// secretVersionsFile is the name of the file in the secrets storage directory
+// recording the versions of updated secrets by their IDs.  The secrets
+// manager has no field for it, and the metadata of secrets is set by users.
+const secretVersionsFile = "versions.json"
+
+// SecretVersions returns the versions of the secrets which have been updated
+// by their IDs.  Secrets which were never updated are not listed, their
+// version is 1.
+func (r *Runtime) SecretVersions() (map[string]int, error) {
+	lock, err := secretVersionsLock(r.GetSecretsStorageDir())
+	if err != nil {
+		return nil, err
+	}
+	lock.RLock()
+	defer lock.Unlock()
+
+	return readSecretVersions(r.GetSecretsStorageDir())
+}
+
+// ReplaceSecretVersion records that the secret oldID was updated, storing its
+// new data as the secret newID, and returns the version of newID.
+func (r *Runtime) ReplaceSecretVersion(oldID, newID string) (int, error) {
+	version := 0
+	err := updateSecretVersions(r.GetSecretsStorageDir(), func(versions map[string]int) {
+		version = max(versions[oldID], 1) + 1
+		delete(versions, oldID)
+		versions[newID] = version
+	})
+	return version, err
+}
+
+// RemoveSecretVersion forgets the version of the removed secret id.
+func (r *Runtime) RemoveSecretVersion(id string) error {
+	return updateSecretVersions(r.GetSecretsStorageDir(), func(versions map[string]int) {
+		delete(versions, id)
+	})
+}
+
+func secretVersionsLock(dir string) (*lockfile.LockFile, error) {
+	return lockfile.GetLockFile(filepath.Join(dir, "versions.lock"))
+}
+
+// readSecretVersions reads the versions of the secrets in the secrets storage
+// directory dir.  The versions must be locked.
+func readSecretVersions(dir string) (map[string]int, error) {
+	versions := make(map[string]int)
+	data, err := os.ReadFile(filepath.Join(dir, secretVersionsFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return versions, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &versions); err != nil {
+		return nil, fmt.Errorf("parsing secret versions: %w", err)
+	}
+	return versions, nil
+}
+
+// updateSecretVersions changes the versions of the secrets in the secrets
+// storage directory dir with update while they are locked.
+func updateSecretVersions(dir string, update func(map[string]int)) error {
+	lock, err := secretVersionsLock(dir)
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	versions, err := readSecretVersions(dir)
+	if err != nil {
+		return err
+	}
+	update(versions)
+	data, err := json.Marshal(versions)
+	if err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(filepath.Join(dir, secretVersionsFile), data, 0o600)
+}
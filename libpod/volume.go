@@ -64,6 +64,10 @@ type VolumeConfig struct {
 	// DisableQuota indicates that the volume should completely disable using any
 	// quota tracking.
 	DisableQuota bool `json:"disableQuota,omitempty"`
+	// QuotaBackend is the backend enforcing the Size and Inodes limits of
+	// the volume. Before creation this is the requested backend, after it
+	// the one in use.
+	QuotaBackend string `json:"quotaBackend,omitempty"`
 	// Timeout allows users to override the default driver timeout of 5 seconds
 	Timeout *uint `json:"timeout,omitempty"`
 	// StorageName is the name of the volume in c/storage. Only used for
@@ -77,6 +81,10 @@ type VolumeConfig struct {
 	StorageImageID string `json:"storageImageID,omitempty"`
 	// MountLabel is the SELinux label to assign to mount points
 	MountLabel string `json:"mountlabel,omitempty"`
+	// SnapshotOf is the name of the volume this volume is a snapshot of.
+	SnapshotOf string `json:"snapshotOf,omitempty"`
+	// ClonedFrom is the name of the volume this volume is a clone of.
+	ClonedFrom string `json:"clonedFrom,omitempty"`
 }
 
 // VolumeState holds the volume's mutable state.
@@ -112,6 +120,12 @@ type VolumeState struct {
 	UIDChowned int `json:"uidChowned,omitempty"`
 	// GIDChowned is the GID the volume was chowned to.
 	GIDChowned int `json:"gidChowned,omitempty"`
+	// RollbackPID is the PID of the process rolling the volume back to a
+	// snapshot.  Containers using the volume cannot be started meanwhile.
+	RollbackPID int `json:"rollbackPID,omitempty"`
+	// RollbackPIDData uniquely identifies the process of RollbackPID, so
+	// the mark is not kept when the PID is reused.
+	RollbackPIDData string `json:"rollbackPIDData,omitempty"`
 }
 
 // Name retrieves the volume's name
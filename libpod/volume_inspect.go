@@ -65,6 +65,8 @@ func (v *Volume) Inspect() (*define.InspectVolumeData, error) {
 	data.NeedsChown = v.state.NeedsChown
 	data.StorageID = v.config.StorageID
 	data.LockNumber = v.lock.ID()
+	data.SnapshotOf = v.config.SnapshotOf
+	data.ClonedFrom = v.config.ClonedFrom
 
 	if v.config.Timeout != nil {
 		data.Timeout = *v.config.Timeout
@@ -72,5 +74,13 @@ func (v *Volume) Inspect() (*define.InspectVolumeData, error) {
 		data.Timeout = v.runtime.config.Engine.VolumePluginTimeout
 	}
 
+	if v.config.QuotaBackend != "" {
+		quota, err := v.quotaUsage()
+		if err != nil {
+			return nil, fmt.Errorf("retrieving quota usage of volume %s: %w", v.Name(), err)
+		}
+		data.Quota = quota
+	}
+
 	return data, nil
 }
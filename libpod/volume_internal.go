@@ -46,6 +46,11 @@ func (v *Volume) needsMount() bool {
 		return true
 	}
 
+	// Loop volumes are stored in an image which must be mounted
+	if v.config.QuotaBackend == define.VolumeQuotaBackendLoop {
+		return true
+	}
+
 	// Commit 28138dafcc added the UID and GID options to this map
 	// However we should only mount when options other than uid and gid are set.
 	// see https://github.com/containers/podman/issues/10620
@@ -111,4 +116,6 @@ func resetVolumeState(state *VolumeState) {
 	state.MountCount = 0
 	state.MountPoint = ""
 	state.CopiedUp = false
+	state.RollbackPID = 0
+	state.RollbackPIDData = ""
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os/exec"
+	"path/filepath"
 	"strings"
 
 	"github.com/containers/podman/v6/libpod/define"
@@ -14,6 +15,10 @@ import (
 	"golang.org/x/sys/unix"
 )
 
+// loopVolumeImage is the name of the filesystem image of volumes using the
+// loop quota backend, in the directory of the volume.
+const loopVolumeImage = "volume.img"
+
 // This is a pseudo-container ID to use when requesting a mount or unmount from
 // the volume plugins.
 // This is the shas256 of the string "placeholder\n".
@@ -80,6 +85,14 @@ func (v *Volume) mount() error {
 	volType := v.config.Options["type"]
 	volOptions := v.config.Options["o"]
 
+	// Loop volumes mount their image, ignoring the size and inodes
+	// options which are enforced by the filesystem in the image.
+	if v.config.QuotaBackend == define.VolumeQuotaBackendLoop {
+		volDevice = v.loopImagePath()
+		volType = "ext4"
+		volOptions = "loop"
+	}
+
 	// Some filesystems (tmpfs) don't have a device, but we still need to
 	// give the kernel something.
 	if volDevice == "" && volType != "" {
@@ -191,3 +204,46 @@ func (v *Volume) unmount(force bool) error {
 
 	return v.save()
 }
+
+// loopImagePath returns the path of the filesystem image of a loop volume.
+func (v *Volume) loopImagePath() string {
+	return filepath.Join(v.runtime.config.Engine.VolumePath, v.Name(), loopVolumeImage)
+}
+
+// quotaUsage returns the limits of the volume and how much of them is used.
+// Must be done while the volume is locked.
+func (v *Volume) quotaUsage() (*define.InspectVolumeQuota, error) {
+	usage := &define.InspectVolumeQuota{
+		Backend: v.config.QuotaBackend,
+		Size:    v.config.Size,
+		Inodes:  v.config.Inodes,
+	}
+
+	switch {
+	case v.config.QuotaBackend == define.VolumeQuotaBackendXFS:
+		// The project quota accounts for the usage of the volume.
+		du, err := v.runtime.volumeQuotaUsage(filepath.Join(v.runtime.config.Engine.VolumePath, v.Name()), v.config.CreatedTime)
+		if err != nil {
+			return nil, fmt.Errorf("getting usage of volume %s: %w", v.Name(), err)
+		}
+		usage.Usage = uint64(du.Size)
+		usage.InodeUsage = uint64(du.InodeCount)
+	case v.state.MountCount > 0:
+		// Loop and tmpfs volumes are mounted, ask the filesystem.
+		var st unix.Statfs_t
+		if err := unix.Statfs(v.config.MountPoint, &st); err != nil {
+			return nil, err
+		}
+		usage.Usage = (uint64(st.Blocks) - uint64(st.Bfree)) * uint64(st.Bsize)
+		usage.InodeUsage = uint64(st.Files) - uint64(st.Ffree)
+	case v.config.QuotaBackend == define.VolumeQuotaBackendLoop:
+		// Report the space allocated by the image of an unmounted
+		// loop volume.
+		var st unix.Stat_t
+		if err := unix.Stat(v.loopImagePath(), &st); err != nil {
+			return nil, fmt.Errorf("getting size of volume %s image: %w", v.Name(), err)
+		}
+		usage.Usage = uint64(st.Blocks) * 512
+	}
+	return usage, nil
+}
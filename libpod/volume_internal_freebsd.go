@@ -3,9 +3,21 @@
 package libpod
 
 import (
+	"errors"
+
 	"golang.org/x/sys/unix"
 )
 
 func detachUnmount(mountPoint string) error {
 	return unix.Unmount(mountPoint, unix.MNT_FORCE)
 }
+
+// checkLoopVolumeSupport returns why volumes cannot be limited in size by
+// storing them in a filesystem image mounted with a loop device, if so.
+func (r *Runtime) checkLoopVolumeSupport() error {
+	return errors.New("the loop quota backend is not supported on FreeBSD")
+}
+
+func (r *Runtime) createLoopVolumeImage(_ string, _ *VolumeConfig) error {
+	return errors.New("volume option quota-backend=loop is not supported on FreeBSD")
+}
@@ -3,9 +3,64 @@
 package libpod
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v6/pkg/rootless"
 	"golang.org/x/sys/unix"
 )
 
 func detachUnmount(mountPoint string) error {
 	return unix.Unmount(mountPoint, unix.MNT_DETACH)
 }
+
+// checkLoopVolumeSupport returns why volumes cannot be limited in size by
+// storing them in a filesystem image mounted with a loop device, if so.
+func (r *Runtime) checkLoopVolumeSupport() error {
+	if rootless.IsRootless() {
+		return errors.New("the loop quota backend requires root privileges")
+	}
+	if _, err := r.config.FindHelperBinary("mkfs.ext4", true); err != nil {
+		return fmt.Errorf("the loop quota backend requires mkfs.ext4: %w", err)
+	}
+	return nil
+}
+
+// createLoopVolumeImage creates a sparse ext4 image with the size and inodes
+// limits of the volume at path. The root directory of the filesystem is owned
+// by the UID and GID of the volume.
+func (r *Runtime) createLoopVolumeImage(path string, config *VolumeConfig) error {
+	if rootless.IsRootless() {
+		return errors.New("volume option quota-backend=loop requires root privileges")
+	}
+	mkfs, err := r.config.FindHelperBinary("mkfs.ext4", true)
+	if err != nil {
+		return fmt.Errorf("volume option quota-backend=loop requires mkfs.ext4: %w", err)
+	}
+
+	image, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	err = image.Truncate(int64(config.Size))
+	if closeErr := image.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	args := []string{"-q", "-F", "-E", fmt.Sprintf("root_owner=%d:%d", config.UID, config.GID)}
+	if config.Inodes > 0 {
+		args = append(args, "-N", strconv.FormatUint(config.Inodes, 10))
+	}
+	args = append(args, path)
+	if out, err := exec.Command(mkfs, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+//go:build !remote && linux && !exclude_disk_quota && cgo
+
+package libpod
+
+import (
+	"time"
+
+	"go.podman.io/storage/drivers/quota"
+	"go.podman.io/storage/pkg/directory"
+)
+
+// volumeQuotaUsage returns the usage of the project quota of the volume
+// directory path, which was created at created.
+func (r *Runtime) volumeQuotaUsage(path string, created time.Time) (*directory.DiskUsage, error) {
+	q, err := r.volumeQuotaControl(created)
+	if err != nil {
+		return nil, err
+	}
+	var du directory.DiskUsage
+	if err := q.GetDiskUsage(path, &du); err != nil {
+		return nil, err
+	}
+	return &du, nil
+}
+
+// volumeQuotaControl returns the project quota control of the volume path
+// to read the usage of volumes with.  It is created again unless it was
+// created after since, so it knows the project IDs of the volumes created
+// until then.
+func (r *Runtime) volumeQuotaControl(since time.Time) (*quota.Control, error) {
+	r.volumeQuotaLock.Lock()
+	defer r.volumeQuotaLock.Unlock()
+
+	if r.volumeQuota == nil || !r.volumeQuotaCreated.After(since) {
+		created := time.Now()
+		q, err := quota.NewControl(r.config.Engine.VolumePath)
+		if err != nil {
+			return nil, err
+		}
+		r.volumeQuota, r.volumeQuotaCreated = q, created
+	}
+	return r.volumeQuota, nil
+}
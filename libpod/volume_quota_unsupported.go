@@ -0,0 +1,17 @@
+//go:build !remote && !(linux && !exclude_disk_quota && cgo)
+
+package libpod
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"go.podman.io/storage/pkg/directory"
+)
+
+// volumeQuotaUsage returns the usage of the project quota of the volume
+// directory path, which was created at created.
+func (r *Runtime) volumeQuotaUsage(path string, _ time.Time) (*directory.DiskUsage, error) {
+	return nil, fmt.Errorf("reading the project quota usage of %s is not supported by this build: %w", path, define.ErrNotImplemented)
+}
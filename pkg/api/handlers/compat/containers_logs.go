@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -32,6 +33,7 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 		Until      string `schema:"until"`
 		Timestamps bool   `schema:"timestamps"`
 		Tail       string `schema:"tail"`
+		Grep       string `schema:"grep"`
 	}{
 		Tail: "all",
 	}
@@ -82,6 +84,12 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	filterMap, err := util.PrepareFilters(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to decode filter parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
 	options := &logs.LogOptions{
 		Details:    true,
 		Follow:     query.Follow,
@@ -89,6 +97,14 @@ func LogsFromContainer(w http.ResponseWriter, r *http.Request) {
 		Until:      until,
 		Tail:       tail,
 		Timestamps: query.Timestamps,
+		Filters:    *filterMap,
+	}
+	if query.Grep != "" {
+		options.Grep, err = regexp.Compile(query.Grep)
+		if err != nil {
+			utils.BadRequest(w, "grep", query.Grep, err)
+			return
+		}
 	}
 
 	var wg sync.WaitGroup
@@ -0,0 +1,181 @@
+//go:build !remote
+
+package compat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v6/pkg/api/types"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/domain/filters"
+	"github.com/containers/podman/v6/pkg/util"
+	"github.com/sirupsen/logrus"
+	cfilters "go.podman.io/common/pkg/filters"
+)
+
+// Monitor streams periodic samples of the host and per-container resource
+// usage.  Containers can be narrowed down with the usual container filters
+// plus a "pod-label" filter matching the labels of the container's pod.
+func Monitor(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := utils.GetDecoder(r)
+
+	query := struct {
+		Stream   bool `schema:"stream"`
+		Interval int  `schema:"interval"`
+	}{
+		Stream:   true,
+		Interval: int(defaultStatsPeriod.Seconds()),
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.Interval < 1 {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("interval must be greater than 0: %w", define.ErrInvalidArg))
+		return
+	}
+
+	filterMap, err := util.PrepareFilters(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to decode filter parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	var podLabels []string
+	filterFuncs := make([]libpod.ContainerFilter, 0, len(*filterMap)+1)
+	for k, v := range *filterMap {
+		if k == "pod-label" {
+			podLabels = v
+			continue
+		}
+		generatedFunc, err := filters.GenerateContainerFilterFuncs(k, v, runtime)
+		if err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		filterFuncs = append(filterFuncs, generatedFunc)
+	}
+	runningOnly, err := filters.GenerateContainerFilterFuncs("status", []string{define.ContainerStateRunning.String()}, runtime)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	filterFuncs = append(filterFuncs, runningOnly)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flush := func() {}
+	if flusher, ok := w.(http.Flusher); ok {
+		flush = flusher.Flush
+	}
+	flush()
+
+	coder := json.NewEncoder(w)
+	coder.SetEscapeHTML(true)
+
+	// previous stats are kept around to compute the CPU percentage
+	// over the sampling interval rather than over the container lifetime.
+	previous := make(map[string]*define.ContainerStats)
+	var previousHost *define.HostStats
+	ticker := time.NewTicker(time.Duration(query.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		report, err := monitorSample(runtime, filterFuncs, podLabels, previousHost, previous)
+		if err != nil {
+			logrus.Errorf("Unable to collect monitor sample: %v", err)
+			return
+		}
+		previousHost = report.Host
+		if err := coder.Encode(report); err != nil {
+			logrus.Errorf("Unable to encode monitor sample: %v", err)
+			return
+		}
+		flush()
+
+		if !query.Stream {
+			return
+		}
+		select {
+		case <-r.Context().Done():
+			logrus.Debugf("Client connection (monitor) cancelled")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// monitorSample collects one MonitorReport for all containers matching
+// filterFuncs and podLabels.  The host CPU usage is computed since
+// previousHost, if set.  Entries in previous are updated in place and pruned
+// for containers which are gone.
+func monitorSample(runtime *libpod.Runtime, filterFuncs []libpod.ContainerFilter, podLabels []string, previousHost *define.HostStats, previous map[string]*define.ContainerStats) (*entities.MonitorReport, error) {
+	host, err := runtime.HostStats(previousHost)
+	if err != nil {
+		return nil, err
+	}
+	ctrs, err := runtime.GetContainers(false, filterFuncs...)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.MonitorReport{
+		Read:       time.Now(),
+		Host:       host,
+		Containers: make([]entities.MonitorContainerReport, 0, len(ctrs)),
+	}
+	podMatches := make(map[string]bool)
+	seen := make(map[string]bool, len(ctrs))
+	for _, ctr := range ctrs {
+		podID := ctr.PodID()
+		if len(podLabels) > 0 {
+			if podID == "" {
+				continue
+			}
+			match, ok := podMatches[podID]
+			if !ok {
+				pod, err := runtime.LookupPod(podID)
+				if err != nil {
+					if errors.Is(err, define.ErrNoSuchPod) {
+						continue
+					}
+					return nil, err
+				}
+				match = cfilters.MatchLabelFilters(podLabels, pod.Labels())
+				podMatches[podID] = match
+			}
+			if !match {
+				continue
+			}
+		}
+
+		stats, err := ctr.GetContainerStats(previous[ctr.ID()])
+		if err != nil {
+			// The container may have stopped or been removed since
+			// we listed it, or never had a cgroup; skip it.
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrStopped) || errors.Is(err, define.ErrNoCgroups) {
+				continue
+			}
+			return nil, err
+		}
+		previous[ctr.ID()] = stats
+		seen[ctr.ID()] = true
+		report.Containers = append(report.Containers, entities.MonitorContainerReport{
+			ContainerStats: *stats,
+			PodID:          podID,
+		})
+	}
+	for id := range previous {
+		if !seen[id] {
+			delete(previous, id)
+		}
+	}
+	return report, nil
+}
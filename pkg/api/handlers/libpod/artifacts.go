@@ -124,6 +124,11 @@ func PullArtifact(w http.ResponseWriter, r *http.Request) {
 
 	artifacts, err := imageEngine.ArtifactPull(r.Context(), query.Name, artifactsPullOptions)
 	if err != nil {
+		// The artifact was rejected by the signature policy
+		if artifacts != nil && artifacts.Error != "" {
+			utils.Error(w, http.StatusForbidden, err)
+			return
+		}
 		var errcd errcode.ErrorCoder
 		// Check to see if any of the wrapped errors is an errcode.ErrorCoder returned from the registry
 		if errors.As(err, &errcd) {
@@ -223,6 +228,7 @@ type artifactAddRequestQuery struct {
 	ArtifactMIMEType string   `schema:"artifactMIMEType"`
 	Append           bool     `schema:"append"`
 	Replace          bool     `schema:"replace"`
+	ReplaceFile      bool     `schema:"replaceFile"`
 	Path             string   `schema:"path"`
 }
 
@@ -303,6 +309,7 @@ func addArtifactHelper(query artifactAddRequestQuery, artifactBlobs []entities.A
 		ArtifactMIMEType: query.ArtifactMIMEType,
 		FileMIMEType:     query.FileMIMEType,
 		Replace:          query.Replace,
+		ReplaceFile:      query.ReplaceFile,
 	}
 
 	imageEngine := abi.ImageEngine{Libpod: runtime}
@@ -0,0 +1,82 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/pkg/api/handlers"
+	"github.com/containers/podman/v6/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v6/pkg/api/types"
+	"github.com/containers/podman/v6/pkg/auth"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/domain/infra/abi"
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	"github.com/gorilla/schema"
+	"go.podman.io/image/v5/types"
+)
+
+// AutoUpdate updates containers according to their auto-update policy.
+func AutoUpdate(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		DryRun      bool `schema:"dryRun"`
+		Rollback    bool `schema:"rollback"`
+		TLSVerify   bool `schema:"tlsVerify"`
+		WaitHealthy uint `schema:"waitHealthy"`
+		Canary      bool `schema:"canary"`
+		BatchSize   uint `schema:"batchSize"`
+		SoakTime    uint `schema:"soakTime"`
+	}{
+		Rollback:  true,
+		TLSVerify: true,
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	_, authfile, err := auth.GetCredentials(r)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer auth.RemoveAuthfile(authfile)
+
+	options := entities.AutoUpdateOptions{
+		Authfile:    authfile,
+		DryRun:      query.DryRun,
+		Rollback:    query.Rollback,
+		WaitHealthy: time.Duration(query.WaitHealthy) * time.Second,
+		Canary:      query.Canary,
+		BatchSize:   int(query.BatchSize),
+		SoakTime:    time.Duration(query.SoakTime) * time.Second,
+	}
+	if _, found := r.URL.Query()["tlsVerify"]; found {
+		options.InsecureSkipTLSVerify = types.NewOptionalBool(!query.TLSVerify)
+	}
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	reports, errs := containerEngine.AutoUpdate(r.Context(), options)
+	if reports == nil && len(errs) > 0 {
+		utils.InternalServerError(w, errorhandling.JoinErrors(errs))
+		return
+	}
+
+	report := handlers.LibpodAutoUpdateReport{
+		Reports: reports,
+		Errors:  errorhandling.ErrorsToStrings(errs),
+	}
+	if report.Reports == nil {
+		report.Reports = []*entities.AutoUpdateReport{}
+	}
+	if report.Errors == nil {
+		report.Errors = []string{}
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
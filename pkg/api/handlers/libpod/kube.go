@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	"go.podman.io/storage/pkg/archive"
 
@@ -124,6 +125,7 @@ func KubePlay(w http.ResponseWriter, r *http.Request) {
 		Wait             bool              `schema:"wait"`
 		Build            bool              `schema:"build"`
 		NoPodPrefix      bool              `schema:"noPodPrefix"`
+		ReadyTimeout     uint              `schema:"readyTimeout"`
 	}{
 		TLSVerify: true,
 		Start:     true,
@@ -200,6 +202,7 @@ func KubePlay(w http.ResponseWriter, r *http.Request) {
 		Wait:               query.Wait,
 		ContextDir:         contextDirectory,
 		NoPodPrefix:        query.NoPodPrefix,
+		ReadyTimeout:       time.Duration(query.ReadyTimeout) * time.Second,
 	}
 	if _, found := r.URL.Query()["build"]; found {
 		options.Build = types.NewOptionalBool(query.Build)
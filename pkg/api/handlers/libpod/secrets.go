@@ -3,6 +3,7 @@
 package libpod
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -68,3 +69,42 @@ func SecretExists(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteResponse(w, http.StatusNoContent, "")
 }
+
+func UpdateSecret(w http.ResponseWriter, r *http.Request) {
+	var (
+		runtime = r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+		decoder = r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	)
+
+	query := struct {
+		Restart bool   `schema:"restart"`
+		Signal  string `schema:"signal"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.Restart && query.Signal != "" {
+		utils.Error(w, http.StatusBadRequest, errors.New("restart and signal cannot be used together"))
+		return
+	}
+
+	name := utils.GetName(r)
+	opts := entities.SecretUpdateOptions{
+		Restart: query.Restart,
+		Signal:  query.Signal,
+	}
+	ic := abi.ContainerEngine{Libpod: runtime}
+	report, err := ic.SecretUpdate(r.Context(), name, r.Body, opts)
+	if err != nil {
+		if errors.Is(err, secrets.ErrNoSuchSecret) {
+			utils.SecretNotFound(w, name, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
@@ -59,9 +59,21 @@ func SystemPrune(w http.ResponseWriter, r *http.Request) {
 }
 
 func DiskUsage(w http.ResponseWriter, r *http.Request) {
-	// Options are only used by the CLI
-	options := entities.SystemDfOptions{}
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	query := struct {
+		Verbose bool `schema:"verbose"`
+	}{}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	// The format is only used by the CLI
+	options := entities.SystemDfOptions{Verbose: query.Verbose}
 	ic := abi.ContainerEngine{Libpod: runtime}
 	response, err := ic.SystemDf(r.Context(), options)
 	if err != nil {
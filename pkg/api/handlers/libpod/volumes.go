@@ -262,3 +262,112 @@ func ImportVolume(w http.ResponseWriter, r *http.Request) {
 
 	utils.WriteResponse(w, http.StatusNoContent, "")
 }
+
+// SnapshotVolume takes a snapshot of a volume
+func SnapshotVolume(w http.ResponseWriter, r *http.Request) {
+	copyVolume(w, r, func(runtime *libpod.Runtime, vol *libpod.Volume, name string, force bool) (*libpod.Volume, error) {
+		return runtime.SnapshotVolume(r.Context(), vol, name, force)
+	})
+}
+
+// CloneVolume clones a volume
+func CloneVolume(w http.ResponseWriter, r *http.Request) {
+	copyVolume(w, r, func(runtime *libpod.Runtime, vol *libpod.Volume, name string, force bool) (*libpod.Volume, error) {
+		return runtime.CloneVolume(r.Context(), vol, name, force)
+	})
+}
+
+// copyVolume creates a copy of the volume with the given copy function and
+// writes the copy as response.
+func copyVolume(w http.ResponseWriter, r *http.Request, copyFunc func(*libpod.Runtime, *libpod.Volume, string, bool) (*libpod.Volume, error)) {
+	var (
+		runtime = r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+		decoder = r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	)
+	query := struct {
+		Name  string `schema:"name"`
+		Force bool   `schema:"force"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	name := utils.GetName(r)
+	vol, err := runtime.LookupVolume(name)
+	if err != nil {
+		utils.VolumeNotFound(w, name, err)
+		return
+	}
+
+	volCopy, err := copyFunc(runtime, vol, query.Name, query.Force)
+	if err != nil {
+		volumeCopyError(w, err)
+		return
+	}
+	inspectOut, err := volCopy.Inspect()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusCreated, entities.VolumeConfigResponse{
+		InspectVolumeData: *inspectOut,
+	})
+}
+
+// RollbackVolume restores a volume from one of its snapshots
+func RollbackVolume(w http.ResponseWriter, r *http.Request) {
+	var (
+		runtime = r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+		decoder = r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	)
+	query := struct {
+		Snapshot string `schema:"snapshot"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	name := utils.GetName(r)
+	vol, err := runtime.LookupVolume(name)
+	if err != nil {
+		utils.VolumeNotFound(w, name, err)
+		return
+	}
+	var snapshot *libpod.Volume
+	if query.Snapshot != "" {
+		snapshot, err = runtime.LookupVolume(query.Snapshot)
+		if err != nil {
+			utils.VolumeNotFound(w, query.Snapshot, err)
+			return
+		}
+	}
+
+	restored, err := runtime.RollbackVolume(r.Context(), vol, snapshot)
+	if err != nil {
+		volumeCopyError(w, err)
+		return
+	}
+	inspectOut, err := restored.Inspect()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, entities.VolumeConfigResponse{
+		InspectVolumeData: *inspectOut,
+	})
+}
+
+func volumeCopyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, define.ErrNoSuchVolume):
+		utils.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, define.ErrVolumeExists), errors.Is(err, define.ErrVolumeBeingUsed):
+		utils.Error(w, http.StatusConflict, err)
+	case errors.Is(err, define.ErrInvalidArg), errors.Is(err, define.ErrNotImplemented):
+		utils.Error(w, http.StatusBadRequest, err)
+	default:
+		utils.InternalServerError(w, err)
+	}
+}
@@ -73,6 +73,13 @@ type imagesRemoveResponseLibpod struct {
 	Body handlers.LibpodImagesRemoveReport
 }
 
+// Auto Update
+// swagger:response
+type autoUpdateResponseLibpod struct {
+	// in:body
+	Body handlers.LibpodAutoUpdateReport
+}
+
 // PlayKube response
 // swagger:response
 type playKubeResponseLibpod struct {
@@ -409,6 +416,15 @@ type podRestartResponse struct {
 	Body entities.PodRestartReport
 }
 
+// Update pod
+// swagger:response
+type podUpdateResponse struct {
+	// in:body
+	Body struct {
+		ID string
+	}
+}
+
 // Start pod
 // swagger:response
 type podStartResponse struct {
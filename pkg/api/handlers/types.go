@@ -45,6 +45,16 @@ type LibpodImagesRemoveReport struct {
 	Errors []string
 }
 
+// LibpodAutoUpdateReport is the return type for auto-update via the rest
+// api.
+type LibpodAutoUpdateReport struct {
+	// Reports of the containers configured for auto updates.
+	Reports []*entities.AutoUpdateReport
+	// Errors encountered while updating the containers.  A failed update
+	// of a container does not prevent others from being updated.
+	Errors []string
+}
+
 // LibpodImagesResolveReport includes a list of fully-qualified image references.
 type LibpodImagesResolveReport struct {
 	// Fully-qualified image references.
@@ -88,6 +98,15 @@ type UpdateEntities struct {
 	UnsetEnv []string
 }
 
+// PodUpdateEntities used to wrap the oci resource spec and the labels of a
+// pod update in a swagger model
+// swagger:model
+type PodUpdateEntities struct {
+	specs.LinuxResources
+	Labels      map[string]string
+	UnsetLabels []string
+}
+
 type Info struct {
 	system.Info
 	BuildahVersion     string
@@ -90,6 +90,8 @@ func (s *APIServer) registerArtifactHandlers(r *mux.Router) error {
 	//     $ref: "#/responses/badParamError"
 	//   401:
 	//     $ref: "#/responses/artifactBadAuth"
+	//   403:
+	//     $ref: "#/responses/artifactSignatureRejected"
 	//   404:
 	//     $ref: "#/responses/artifactNotFound"
 	//   500:
@@ -196,6 +198,11 @@ func (s *APIServer) registerArtifactHandlers(r *mux.Router) error {
 	//     description: Replace an existing artifact with the same name
 	//     type: boolean
 	//     default: false
+	//   - name: replaceFile
+	//     in: query
+	//     description: Replace the file with the same name in an existing artifact, keeping all other files
+	//     type: boolean
+	//     default: false
 	//   - name: inputStream
 	//     in: body
 	//     description: Binary stream of the file to add to an artifact
@@ -261,6 +268,11 @@ func (s *APIServer) registerArtifactHandlers(r *mux.Router) error {
 	//     description: Replace an existing artifact with the same name
 	//     type: boolean
 	//     default: false
+	//   - name: replaceFile
+	//     in: query
+	//     description: Replace the file with the same name in an existing artifact, keeping all other files
+	//     type: boolean
+	//     default: false
 	// responses:
 	//   201:
 	//     $ref: "#/responses/artifactAddResponse"
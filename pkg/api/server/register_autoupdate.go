@@ -0,0 +1,72 @@
+//go:build !remote
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v6/pkg/api/handlers/libpod"
+	"github.com/gorilla/mux"
+)
+
+func (s *APIServer) registerAutoUpdateHandlers(r *mux.Router) error {
+	// swagger:operation POST /libpod/autoupdate libpod AutoUpdateLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Auto update containers
+	// description: |
+	//   Auto update containers according to their auto-update policy.  Containers are expected to run in systemd units
+	//   which create new containers in order to run the updated images.  See podman-auto-update(1) for details.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: header
+	//    name: X-Registry-Auth
+	//    type: string
+	//    description: A base64-encoded auth configuration.
+	//  - in: query
+	//    name: dryRun
+	//    type: boolean
+	//    default: false
+	//    description: Only check for but do not perform any update.
+	//  - in: query
+	//    name: rollback
+	//    type: boolean
+	//    default: true
+	//    description: Roll back to the previous image if the update fails.
+	//  - in: query
+	//    name: tlsVerify
+	//    type: boolean
+	//    default: true
+	//    description: Require TLS verification when contacting registries.
+	//  - in: query
+	//    name: waitHealthy
+	//    type: integer
+	//    default: 0
+	//    description: Seconds to wait for updated containers with a healthcheck to become healthy.
+	//  - in: query
+	//    name: canary
+	//    type: boolean
+	//    default: false
+	//    description: Update a single unit first in a staged rollout.
+	//  - in: query
+	//    name: batchSize
+	//    type: integer
+	//    default: 0
+	//    description: Number of units to update per stage of a staged rollout, 0 for all.
+	//  - in: query
+	//    name: soakTime
+	//    type: integer
+	//    default: 0
+	//    description: Seconds the units of a rollout stage must keep running before the next stage is started.
+	// responses:
+	//   200:
+	//     $ref: "#/responses/autoUpdateResponseLibpod"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/autoupdate"), s.APIHandler(libpod.AutoUpdate)).Methods(http.MethodPost)
+	return nil
+}
@@ -1036,6 +1036,19 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    default: false
 	//    description: Add timestamps to every log line
 	//  - in: query
+	//    name: grep
+	//    type: string
+	//    description: Only return log lines whose message matches this regular expression
+	//  - in: query
+	//    name: filters
+	//    type: string
+	//    description: |
+	//      JSON encoded value of the filters (a map[string][]string) to process on the log lines. Available filters:
+	//        - `stream=<stdout|stderr>` Log lines of the given stream
+	//        - `partial=<true|false>` Log lines which are or are not partial
+	//        - `<field>=<value>` Log lines holding a JSON object whose field has the given value, with the names of nested fields joined by dots
+	//      Lines must match all filters, but any of the values given for a filter.
+	//  - in: query
 	//    name: tail
 	//    type: string
 	//    description: Only return this number of log lines from the end of the logs
@@ -135,6 +135,11 @@ func (s *APIServer) registerKubeHandlers(r *mux.Router) error {
 	//    type: string
 	//    description: Set the user namespace mode for the pods.
 	//  - in: query
+	//    name: readyTimeout
+	//    type: integer
+	//    default: 300
+	//    description: Seconds to wait for a replica of a StatefulSet to become ready before starting the next one.
+	//  - in: query
 	//    name: wait
 	//    type: boolean
 	//    default: false
@@ -209,7 +214,7 @@ func (s *APIServer) registerKubeHandlers(r *mux.Router) error {
 	//    type: integer
 	//    format: int32
 	//    default: 0
-	//    description: Set the replica number for Deployment kind.
+	//    description: Set the replica number for Deployment or StatefulSet kind.
 	//  - in: query
 	//    name: noTrunc
 	//    type: boolean
@@ -3,13 +3,82 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/containers/podman/v6/pkg/api/handlers/compat"
 	"github.com/gorilla/mux"
 )
 
 func (s *APIServer) registerMonitorHandlers(r *mux.Router) error {
-	r.Handle(VersionedPath("/monitor"), s.APIHandler(compat.UnsupportedHandler))
+	// swagger:operation GET /monitor system SystemMonitor
+	// ---
+	// tags:
+	//   - system (compat)
+	// summary: Monitor system resources
+	// description: Stream periodic samples of host and per-container resource usage (CPU, memory, block IO, network)
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: interval
+	//   type: integer
+	//   in: query
+	//   default: 5
+	//   description: time in seconds between samples
+	// - name: stream
+	//   type: boolean
+	//   in: query
+	//   default: true
+	//   description: when false, emit a single sample and return
+	// - name: filters
+	//   type: string
+	//   in: query
+	//   description: |
+	//      JSON encoded value of the filters (a map[string][]string) to process on the container list. Available filters:
+	//      - container filters as accepted by `/containers/json`, e.g. `label=<key>` or `label=<key>=<value>`, `pod=<pod id or name>`
+	//      - `pod-label=<key>` or `pod-label=<key>=<value>` matches containers whose pod has the given label
+	// responses:
+	//   200:
+	//     description: returns a stream of json data describing host and container resource usage
+	//   400:
+	//     "$ref": "#/responses/badParamError"
+	//   500:
+	//     "$ref": "#/responses/internalError"
+	r.Handle(VersionedPath("/monitor"), s.StreamBufferedAPIHandler(compat.Monitor)).Methods(http.MethodGet)
 	// Added non version path to URI to support docker non versioned paths
-	r.Handle("/monitor", s.APIHandler(compat.UnsupportedHandler))
+	r.Handle("/monitor", s.StreamBufferedAPIHandler(compat.Monitor)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/monitor system SystemMonitorLibpod
+	// ---
+	// tags:
+	//   - system
+	// summary: Monitor system resources
+	// description: Stream periodic samples of host and per-container resource usage (CPU, memory, block IO, network)
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: interval
+	//   type: integer
+	//   in: query
+	//   default: 5
+	//   description: time in seconds between samples
+	// - name: stream
+	//   type: boolean
+	//   in: query
+	//   default: true
+	//   description: when false, emit a single sample and return
+	// - name: filters
+	//   type: string
+	//   in: query
+	//   description: |
+	//      JSON encoded value of the filters (a map[string][]string) to process on the container list. Available filters:
+	//      - container filters as accepted by `/containers/json`, e.g. `label=<key>` or `label=<key>=<value>`, `pod=<pod id or name>`
+	//      - `pod-label=<key>` or `pod-label=<key>=<value>` matches containers whose pod has the given label
+	// responses:
+	//   200:
+	//     description: returns a stream of json data describing host and container resource usage
+	//   400:
+	//     "$ref": "#/responses/badParamError"
+	//   500:
+	//     "$ref": "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/monitor"), s.StreamBufferedAPIHandler(compat.Monitor)).Methods(http.MethodGet)
 	return nil
 }
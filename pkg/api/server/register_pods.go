@@ -214,6 +214,46 @@ func (s *APIServer) registerPodsHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/pods/{name}/restart"), s.APIHandler(libpod.PodRestart)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/pods/{name}/update pods PodUpdateLibpod
+	// ---
+	// summary: Update a pod
+	// description: |
+	//   Update the resource limits, restart policy and labels of an existing pod.  Resource limits are applied to
+	//   the pod cgroup immediately.  The restart policy is applied to the infra container and to all containers
+	//   using the pod's restart policy.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the pod
+	//  - in: query
+	//    name: restartPolicy
+	//    type: string
+	//    required: false
+	//    description: New restart policy for the pod.
+	//  - in: query
+	//    name: restartRetries
+	//    type: integer
+	//    required: false
+	//    description: New amount of retries for the pod's restart policy. Only allowed if restartPolicy is set to on-failure
+	//  - in: body
+	//    name: config
+	//    description: attributes for updating the pod
+	//    schema:
+	//      $ref: "#/definitions/PodUpdateEntities"
+	// responses:
+	//   201:
+	//     $ref: '#/responses/podUpdateResponse'
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/podNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/pods/{name}/update"), s.APIHandler(libpod.PodUpdate)).Methods(http.MethodPost)
 	// swagger:operation POST /libpod/pods/{name}/start pods PodStartLibpod
 	// ---
 	// summary: Start a pod
@@ -117,6 +117,46 @@ func (s *APIServer) registerSecretHandlers(r *mux.Router) error {
 	//   '500':
 	//     "$ref": "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/secrets/{name}/exists"), s.APIHandler(libpod.SecretExists)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/secrets/{name}/update libpod SecretUpdateLibpod
+	// ---
+	// tags:
+	//  - secrets
+	// summary: Update a secret
+	// description: |
+	//   Replace the data of a secret and propagate it to the containers using the secret.  Secrets mounted into
+	//   containers are updated immediately, secrets set as environment variables when the container is restarted.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the secret
+	//  - in: query
+	//    name: restart
+	//    type: boolean
+	//    default: false
+	//    description: Restart the running containers using the secret
+	//  - in: query
+	//    name: signal
+	//    type: string
+	//    description: Signal to send to the running containers mounting the secret, e.g. SIGHUP
+	//  - in: body
+	//    name: request
+	//    description: Secret data
+	//    schema:
+	//      type: string
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     $ref: "#/responses/SecretUpdateResponse"
+	//   '400':
+	//     "$ref": "#/responses/badParamError"
+	//   '404':
+	//     "$ref": "#/responses/NoSuchSecret"
+	//   '500':
+	//     "$ref": "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/secrets/{name}/update"), s.APIHandler(libpod.UpdateSecret)).Methods(http.MethodPost)
 	// swagger:operation DELETE /libpod/secrets/{name} libpod SecretDeleteLibpod
 	// ---
 	// tags:
@@ -81,11 +81,19 @@ func (s *APIServer) registerSystemHandlers(r *mux.Router) error {
 	//   - system
 	// summary: Show disk usage
 	// description: Return information about disk usage for containers, images, and volumes
+	// parameters:
+	//   - in: query
+	//     name: verbose
+	//     type: boolean
+	//     description: Also report the images sharing each layer and the disk usage of artifacts and the build cache
+	//     default: false
 	// produces:
 	// - application/json
 	// responses:
 	//   200:
 	//     $ref: '#/responses/systemDiskUsage'
+	//   400:
+	//     $ref: "#/responses/badParamError"
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/system/df"), s.APIHandler(libpod.DiskUsage)).Methods(http.MethodGet)
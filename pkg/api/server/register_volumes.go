@@ -202,6 +202,110 @@ func (s *APIServer) registerVolumeHandlers(r *mux.Router) error {
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/volumes/{name}/import"), s.APIHandler(libpod.ImportVolume)).Methods(http.MethodPost)
 
+	// swagger:operation POST /libpod/volumes/{name}/snapshot libpod VolumeSnapshotLibpod
+	// ---
+	// tags:
+	//  - volumes
+	// summary: Snapshot a volume
+	// description: Create a new volume with a point-in-time copy of the contents of a volume of the local driver, which the volume can be rolled back to.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the volume
+	//  - in: query
+	//    name: name
+	//    type: string
+	//    description: the name of the snapshot, generated from the name of the volume and the current time if not set
+	//  - in: query
+	//    name: force
+	//    type: boolean
+	//    default: false
+	//    description: take the snapshot even if the volume is used by a running container
+	// produces:
+	// - application/json
+	// responses:
+	//   201:
+	//     $ref: "#/responses/volumeCreateResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/volumeNotFound"
+	//   409:
+	//     description: A volume with the name of the snapshot already exists, or the volume is used by a running container
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/volumes/{name}/snapshot"), s.APIHandler(libpod.SnapshotVolume)).Methods(http.MethodPost)
+
+	// swagger:operation POST /libpod/volumes/{name}/clone libpod VolumeCloneLibpod
+	// ---
+	// tags:
+	//  - volumes
+	// summary: Clone a volume
+	// description: Create a new volume with a copy of the contents of a volume of the local driver.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the volume
+	//  - in: query
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the clone
+	//  - in: query
+	//    name: force
+	//    type: boolean
+	//    default: false
+	//    description: clone the volume even if it is used by a running container
+	// produces:
+	// - application/json
+	// responses:
+	//   201:
+	//     $ref: "#/responses/volumeCreateResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/volumeNotFound"
+	//   409:
+	//     description: A volume with the name of the clone already exists, or the volume is used by a running container
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/volumes/{name}/clone"), s.APIHandler(libpod.CloneVolume)).Methods(http.MethodPost)
+
+	// swagger:operation POST /libpod/volumes/{name}/rollback libpod VolumeRollbackLibpod
+	// ---
+	// tags:
+	//  - volumes
+	// summary: Roll a volume back to a snapshot
+	// description: Replace the contents of a volume with the contents of one of its snapshots and return the snapshot. The volume must not be used by a running container.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the volume
+	//  - in: query
+	//    name: snapshot
+	//    type: string
+	//    description: the name of the snapshot to restore, the most recent snapshot of the volume if not set
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/volumeCreateResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/volumeNotFound"
+	//   409:
+	//     description: The volume is used by a running container
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/volumes/{name}/rollback"), s.APIHandler(libpod.RollbackVolume)).Methods(http.MethodPost)
+
 	/*
 	 * Docker compatibility endpoints
 	 */
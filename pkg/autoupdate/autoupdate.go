@@ -7,19 +7,27 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
+	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/systemd"
 	systemdDefine "github.com/containers/podman/v6/pkg/systemd/define"
+	"github.com/containers/podman/v6/pkg/systemd/parser"
+	"github.com/containers/podman/v6/pkg/systemd/quadlet"
 	"github.com/coreos/go-systemd/v22/dbus"
 	"github.com/sirupsen/logrus"
 	"go.podman.io/common/libimage"
 	"go.podman.io/common/pkg/config"
 	"go.podman.io/image/v5/docker"
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/image/v5/types"
+	"go.podman.io/storage/pkg/ioutils"
 )
 
 // Policy represents an auto-update policy.
@@ -32,6 +40,9 @@ const (
 	PolicyRegistryImage = "registry"
 	// PolicyLocalImage is the policy to run auto-update based on a local image
 	PolicyLocalImage = "local"
+	// PolicySemverImage is the policy to update to the highest tag on the
+	// registry matching the semantic version constraint of the container.
+	PolicySemverImage = "semver"
 )
 
 // Map for easy lookups of supported policies.
@@ -41,6 +52,7 @@ var supportedPolicies = map[string]Policy{
 	"image":                     PolicyRegistryImage, // Deprecated in favor of PolicyRegistryImage
 	string(PolicyRegistryImage): PolicyRegistryImage,
 	string(PolicyLocalImage):    PolicyLocalImage,
+	string(PolicySemverImage):   PolicySemverImage,
 }
 
 // updater includes shared state for auto-updating one or more containers.
@@ -58,18 +70,25 @@ const (
 	statusNotUpdated = "false"       // No update was needed
 	statusPending    = "pending"     // The update is pending (see options.DryRun)
 	statusRolledBack = "rolled back" // Rollback after a failed update
+	statusAborted    = "aborted"     // Not updated after a failed rollout stage
 )
 
 // task includes data and state for updating a container
 type task struct {
-	authfile     string            // Container-specific authfile
-	auto         *updater          // Reverse pointer to the updater
-	container    *libpod.Container // Container to update
-	policy       Policy            // Update policy
-	image        *libimage.Image   // Original image before the update
-	rawImageName string            // The container's raw image name
-	status       string            // Auto-update status
-	unit         string            // Name of the systemd unit
+	authfile     string              // Container-specific authfile
+	auto         *updater            // Reverse pointer to the updater
+	container    *libpod.Container   // Container to update
+	health       string              // Health status of the updated container
+	policy       Policy              // Update policy
+	image        *libimage.Image     // Original image before the update
+	rawImageName string              // The container's raw image name
+	semver       *semver.Constraints // Version constraint of the semver policy
+	semverImage  string              // Image matching the version constraint
+	quadletFile  string              // Quadlet file whose image reference has been updated
+	quadletOld   []byte              // Content of the auto-update drop-in before the update, nil if there was none
+	stage        int                 // Stage of a staged rollout
+	status       string              // Auto-update status
+	unit         string              // Name of the systemd unit
 }
 
 // LookupPolicy looks up the corresponding Policy for the specified
@@ -107,6 +126,9 @@ func LookupPolicy(s string) (Policy, error) {
 // of a running container is different than the local one. If the image digests
 // differ, it restarts the systemd unit with the new image.
 //
+// If a staged rollout is requested (see AutoUpdateOptions.Canary), the units
+// are updated in stages which must succeed before the next one is started.
+//
 // It returns a slice of successfully restarted systemd units and a slice of
 // errors encountered during auto update.
 func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
@@ -140,12 +162,27 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 
 	runtime.NewSystemEvent(events.AutoUpdate)
 
+	// Process the units in a stable order to make staged rollouts
+	// predictable.
+	units := make([]string, 0, len(auto.unitToTasks))
+	for unit := range auto.unitToTasks {
+		units = append(units, unit)
+	}
+	sort.Strings(units)
+
 	// Update all images/container according to their auto-update policy.
+	if auto.staged() {
+		allErrors = append(allErrors, auto.rollout(ctx, units)...)
+	} else {
+		for _, unit := range units {
+			_, unitErrors := auto.updateUnit(ctx, unit, auto.unitToTasks[unit])
+			allErrors = append(allErrors, unitErrors...)
+		}
+	}
+
 	var allReports []*entities.AutoUpdateReport
-	for unit, tasks := range auto.unitToTasks {
-		unitErrors := auto.updateUnit(ctx, unit, tasks)
-		allErrors = append(allErrors, unitErrors...)
-		for _, task := range tasks {
+	for _, unit := range units {
+		for _, task := range auto.unitToTasks[unit] {
 			allReports = append(allReports, task.report())
 		}
 	}
@@ -153,8 +190,155 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 	return allReports, allErrors
 }
 
-// updateUnit auto updates the tasks in the specified systemd unit.
-func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []error {
+// staged returns whether the units are updated in a staged rollout.
+func (u *updater) staged() bool {
+	return u.options.Canary || u.options.BatchSize > 0 || u.options.SoakTime > 0
+}
+
+// rollout updates the units in stages.  The first stage updates a single
+// canary unit if requested, the following ones up to BatchSize units each.
+// Only units with an available update count towards a stage.  After each
+// stage, the updated units must keep running for SoakTime.  If a unit of a
+// stage fails, the rollout is aborted: the remaining units are not updated
+// and, if rollbacks are enabled, all units updated so far are rolled back.
+func (u *updater) rollout(ctx context.Context, units []string) []error {
+	var (
+		errs    []error
+		failure error
+		stage   = 1
+		batch   []string // Units updated in the current stage
+		updated []string // Units updated in previous stages
+	)
+
+	stageSize := u.options.BatchSize
+	if u.options.Canary {
+		stageSize = 1
+	}
+
+	for i, unit := range units {
+		tasks := u.unitToTasks[unit]
+		if failure != nil {
+			for _, task := range tasks {
+				task.status = statusAborted
+			}
+			continue
+		}
+
+		restarted, unitErrors := u.updateUnit(ctx, unit, tasks)
+		errs = append(errs, unitErrors...)
+		if restarted {
+			for _, task := range tasks {
+				task.stage = stage
+			}
+			if tasks[0].status != statusUpdated {
+				failure = fmt.Errorf("unit %s failed to update", unit)
+				continue
+			}
+			batch = append(batch, unit)
+		}
+
+		// Complete the stage once it is full or all units are processed.
+		full := stageSize > 0 && len(batch) >= stageSize
+		last := i == len(units)-1
+		if len(batch) == 0 || !full && !last {
+			continue
+		}
+		if err := u.soak(ctx, batch); err != nil {
+			failure = err
+			continue
+		}
+		logrus.Infof("Completed stage %d of the rollout: %v", stage, batch)
+		updated = append(updated, batch...)
+		batch = nil
+		stage++
+		stageSize = u.options.BatchSize
+	}
+
+	if failure == nil {
+		return errs
+	}
+	errs = append(errs, fmt.Errorf("aborting rollout in stage %d: %w", stage, failure))
+
+	if !u.options.Rollback {
+		return errs
+	}
+	for _, unit := range append(updated, batch...) {
+		errs = append(errs, u.rollbackUnit(ctx, unit, u.unitToTasks[unit], failure)...)
+	}
+	return errs
+}
+
+// soak checks that the units stay active and the containers of their tasks
+// keep running without becoming unhealthy for the soak time.
+func (u *updater) soak(ctx context.Context, units []string) error {
+	deadline := time.Now().Add(u.options.SoakTime)
+	for {
+		for _, unit := range units {
+			if err := u.checkUnit(ctx, unit); err != nil {
+				for _, unit := range units {
+					for _, task := range u.unitToTasks[unit] {
+						task.status = statusFailed
+					}
+				}
+				return err
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(min(remaining, time.Second)):
+		}
+	}
+}
+
+// checkUnit returns an error if the unit is not active, or if a container of
+// its tasks is not running or unhealthy.
+func (u *updater) checkUnit(ctx context.Context, unit string) error {
+	prop, err := u.conn.GetUnitPropertyContext(ctx, unit, "ActiveState")
+	if err != nil {
+		return fmt.Errorf("checking state of unit %s: %w", unit, err)
+	}
+	if state, _ := prop.Value.Value().(string); state != "active" {
+		return fmt.Errorf("unit %s is %s", unit, state)
+	}
+
+	for _, task := range u.unitToTasks[unit] {
+		// Restarting the unit has recreated the container, so look it
+		// up by name.
+		ctr, err := u.runtime.LookupContainer(task.container.Name())
+		if err != nil {
+			return fmt.Errorf("looking up updated container %s: %w", task.container.Name(), err)
+		}
+		state, err := ctr.State()
+		if err != nil {
+			return fmt.Errorf("checking state of updated container %s: %w", ctr.Name(), err)
+		}
+		if state != define.ContainerStateRunning {
+			return fmt.Errorf("updated container %s is %s", ctr.Name(), state)
+		}
+		if !ctr.HasHealthCheck() {
+			continue
+		}
+		status, err := ctr.HealthCheckStatus()
+		if err != nil {
+			return fmt.Errorf("checking health of updated container %s: %w", ctr.Name(), err)
+		}
+		task.health = status
+		if status == define.HealthCheckUnhealthy {
+			return fmt.Errorf("updated container %s is %s", ctr.Name(), status)
+		}
+	}
+	return nil
+}
+
+// updateUnit auto updates the tasks in the specified systemd unit.  It
+// returns whether the unit has been restarted with updated images.
+func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) (bool, []error) {
 	var errors []error
 	tasksUpdated := false
 
@@ -191,10 +375,15 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 
 	// If no task has been updated, we can jump directly to the next unit.
 	if !tasksUpdated {
-		return errors
+		return false, errors
 	}
 
 	updateError := u.restartSystemdUnit(ctx, unit)
+	healthError := false
+	if updateError == nil && u.options.WaitHealthy > 0 {
+		updateError = u.waitHealthy(ctx, tasks)
+		healthError = updateError != nil
+	}
 	for _, task := range tasks {
 		if updateError == nil {
 			task.status = statusUpdated
@@ -208,12 +397,22 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 		if updateError != nil {
 			errors = append(errors, fmt.Errorf("restarting unit %s during update: %w", unit, updateError))
 		}
-		return errors
+		if healthError {
+			u.newContainerEvents(tasks, (*libpod.Container).NewUpdateUnhealthyEvent)
+		}
+		return true, errors
 	}
 
 	// The update has failed and rollbacks are enabled.
+	return true, append(errors, u.rollbackUnit(ctx, unit, tasks, updateError)...)
+}
+
+// rollbackUnit rolls back the images of the tasks in the specified systemd
+// unit and restarts it.  cause is the error which led to the rollback.
+func (u *updater) rollbackUnit(ctx context.Context, unit string, tasks []*task, cause error) []error {
+	var errors []error
 	for _, task := range tasks {
-		if err := task.rollbackImage(); err != nil {
+		if err := task.rollbackImage(ctx); err != nil {
 			err = fmt.Errorf("rolling back image for container %s in unit %s: %w", task.container.ID(), unit, err)
 			errors = append(errors, err)
 		}
@@ -231,22 +430,93 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 	for _, task := range tasks {
 		task.status = statusRolledBack
 	}
+	u.newContainerEvents(tasks, (*libpod.Container).NewRollbackEvent)
+	logrus.Infof("Rolled back systemd unit %q: %v", unit, cause)
 
 	return errors
 }
 
+// restartedContainer looks up the container of the task after its unit has
+// been restarted.  Restarting the unit has recreated the container, so it is
+// looked up by name.
+func (u *updater) restartedContainer(task *task) (*libpod.Container, error) {
+	ctr, err := u.runtime.LookupContainer(task.container.Name())
+	if err != nil {
+		return nil, fmt.Errorf("looking up restarted container %s: %w", task.container.Name(), err)
+	}
+	return ctr, nil
+}
+
+// newContainerEvents creates an event with newEvent for the restarted
+// container of each task.
+func (u *updater) newContainerEvents(tasks []*task, newEvent func(*libpod.Container)) {
+	for _, task := range tasks {
+		ctr, err := u.restartedContainer(task)
+		if err != nil {
+			logrus.Errorf("Creating event: %v", err)
+			continue
+		}
+		newEvent(ctr)
+	}
+}
+
+// waitHealthy waits for the containers of the tasks to become healthy after
+// their unit has been restarted.  Containers without a healthcheck are not
+// waited for.
+func (u *updater) waitHealthy(ctx context.Context, tasks []*task) error {
+	ctx, cancel := context.WithTimeout(ctx, u.options.WaitHealthy)
+	defer cancel()
+
+	for _, task := range tasks {
+		ctr, err := u.restartedContainer(task)
+		if err != nil {
+			return err
+		}
+		if !ctr.HasHealthCheck() {
+			continue
+		}
+
+		_, waitErr := ctr.WaitForConditionWithInterval(ctx, time.Second, define.HealthCheckHealthy, define.HealthCheckUnhealthy)
+		status, err := ctr.HealthCheckStatus()
+		if err != nil {
+			return fmt.Errorf("checking health of updated container %s: %w", ctr.Name(), err)
+		}
+		task.health = status
+		if ctx.Err() != nil {
+			return fmt.Errorf("updated container %s did not become healthy within %s", ctr.Name(), u.options.WaitHealthy)
+		}
+		if waitErr != nil {
+			return fmt.Errorf("waiting for updated container %s to become healthy: %w", ctr.Name(), waitErr)
+		}
+		if status != define.HealthCheckHealthy {
+			return fmt.Errorf("updated container %s is %s", ctr.Name(), status)
+		}
+	}
+	return nil
+}
+
 // report creates an auto-update report for the task.
 func (t *task) report() *entities.AutoUpdateReport {
 	return &entities.AutoUpdateReport{
 		ContainerID:   t.container.ID(),
 		ContainerName: t.container.Name(),
-		ImageName:     t.container.RawImageName(),
+		ImageName:     t.imageName(),
 		Policy:        string(t.policy),
 		SystemdUnit:   t.unit,
 		Updated:       t.status,
+		Stage:         t.stage,
+		Health:        t.health,
 	}
 }
 
+// imageName returns the name of the image the task updates to.
+func (t *task) imageName() string {
+	if t.semverImage != "" {
+		return t.semverImage
+	}
+	return t.container.RawImageName()
+}
+
 // updateAvailable returns whether an update for the task is available.
 func (t *task) updateAvailable(ctx context.Context) (bool, error) {
 	switch t.policy {
@@ -254,6 +524,8 @@ func (t *task) updateAvailable(ctx context.Context) (bool, error) {
 		return t.registryUpdateAvailable(ctx)
 	case PolicyLocalImage:
 		return t.localUpdateAvailable()
+	case PolicySemverImage:
+		return t.semverUpdateAvailable(ctx)
 	default:
 		return false, fmt.Errorf("unexpected auto-update policy %s for container %s", t.policy, t.container.ID())
 	}
@@ -267,6 +539,8 @@ func (t *task) update(ctx context.Context) error {
 	case PolicyLocalImage:
 		// Nothing to do as the image is already available in the local storage.
 		return nil
+	case PolicySemverImage:
+		return t.semverUpdate(ctx)
 	default:
 		return fmt.Errorf("unexpected auto-update policy %s for container %s", t.policy, t.container.ID())
 	}
@@ -310,6 +584,188 @@ func (t *task) registryUpdate(ctx context.Context) error {
 	return nil
 }
 
+// semverUpdateAvailable returns whether the highest tag on the registry
+// matching the version constraint refers to a different image than the
+// container's.
+func (t *task) semverUpdateAvailable(ctx context.Context) (bool, error) {
+	named, err := reference.ParseNormalizedNamed(t.rawImageName)
+	if err != nil {
+		return false, err
+	}
+	repo := reference.TrimNamed(named)
+	repoRef, err := docker.NewReference(repo)
+	if err != nil {
+		return false, err
+	}
+	sys := &types.SystemContext{
+		AuthFilePath:                t.authfile,
+		DockerInsecureSkipTLSVerify: t.auto.options.InsecureSkipTLSVerify,
+	}
+	tags, err := docker.GetRepositoryTags(ctx, sys, repoRef)
+	if err != nil {
+		return false, fmt.Errorf("listing tags of %s: %w", repo.Name(), err)
+	}
+	tag, err := highestMatchingTag(tags, t.semver)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", repo.Name(), err)
+	}
+	tagged, err := reference.WithTag(repo, tag)
+	if err != nil {
+		return false, err
+	}
+	t.semverImage = tagged.String()
+
+	// The image has already been pulled for another task.
+	if _, exists := t.auto.updatedRawImages[t.semverImage]; exists {
+		return true, nil
+	}
+
+	remoteRef, err := docker.NewReference(tagged)
+	if err != nil {
+		return false, err
+	}
+	options := &libimage.HasDifferentDigestOptions{
+		AuthFilePath:          t.authfile,
+		InsecureSkipTLSVerify: t.auto.options.InsecureSkipTLSVerify,
+	}
+	return t.image.HasDifferentDigest(ctx, remoteRef, options)
+}
+
+// semverUpdate pulls down the image matching the version constraint and
+// updates the image reference of the Quadlet file of the container's systemd
+// unit, such that the restarted unit runs the new version.
+func (t *task) semverUpdate(ctx context.Context) error {
+	lookupImage := func() (*libimage.Image, error) {
+		image, _, err := t.auto.runtime.LibimageRuntime().LookupImage(t.semverImage, nil)
+		return image, err
+	}
+
+	if _, exists := t.auto.updatedRawImages[t.semverImage]; exists {
+		if _, err := lookupImage(); err != nil {
+			return err
+		}
+	} else {
+		pullOptions := &libimage.PullOptions{}
+		pullOptions.AuthFilePath = t.authfile
+		pullOptions.Writer = os.Stderr
+		pullOptions.InsecureSkipTLSVerify = t.auto.options.InsecureSkipTLSVerify
+		if _, err := t.auto.runtime.LibimageRuntime().Pull(ctx, t.semverImage, config.PullPolicyAlways, pullOptions); err != nil {
+			return err
+		}
+		t.auto.updatedRawImages[t.semverImage] = true
+	}
+
+	return t.setQuadletImage(ctx, t.semverImage)
+}
+
+// quadletDropIn is the name of the drop-in overriding the image reference of
+// a Quadlet file.  It sorts last to take precedence over other drop-ins.
+const quadletDropIn = "99-podman-auto-update.conf"
+
+// quadletDropInPath returns the path of the auto-update drop-in of the Quadlet
+// file.
+func quadletDropInPath(path string) string {
+	return filepath.Join(path+".d", quadletDropIn)
+}
+
+// setQuadletImage overrides the image reference of the Quadlet file the
+// systemd unit of the task has been generated from with a drop-in and reloads
+// systemd.  The Quadlet file itself is left untouched.  Other units are not
+// supported as their image reference cannot be updated.
+func (t *task) setQuadletImage(ctx context.Context, image string) error {
+	if t.quadletFile == "" {
+		prop, err := t.auto.conn.GetUnitPropertyContext(ctx, t.unit, "SourcePath")
+		if err != nil {
+			return fmt.Errorf("looking up source path of systemd unit %s: %w", t.unit, err)
+		}
+		path, _ := prop.Value.Value().(string)
+		if filepath.Ext(path) != ".container" {
+			return fmt.Errorf("systemd unit %s has not been generated from a Quadlet .container file, the %s policy can only update Quadlet containers", t.unit, PolicySemverImage)
+		}
+
+		unitFile, err := parser.ParseUnitFile(path)
+		if err != nil {
+			return err
+		}
+		old, err := os.ReadFile(quadletDropInPath(path))
+		switch {
+		case err == nil:
+			dropIn, err := parser.ParseUnitFile(quadletDropInPath(path))
+			if err != nil {
+				return err
+			}
+			unitFile.Merge(dropIn)
+		case errors.Is(err, os.ErrNotExist):
+			old = nil
+		default:
+			return err
+		}
+		current, ok := unitFile.Lookup(quadlet.ContainerGroup, quadlet.KeyImage)
+		if !ok {
+			return fmt.Errorf("no %s key in %s", quadlet.KeyImage, path)
+		}
+		if current != t.rawImageName {
+			return fmt.Errorf("image %s of %s is not the image %s of container %s", current, path, t.rawImageName, t.container.ID())
+		}
+		t.quadletFile, t.quadletOld = path, old
+	}
+
+	dropIn := parser.NewUnitFile()
+	dropIn.Add(quadlet.ContainerGroup, quadlet.KeyImage, image)
+	dropIn.PrependComment(quadlet.ContainerGroup,
+		"Written by podman auto-update, remove this file to run the image of",
+		filepath.Base(t.quadletFile)+" again.")
+	data, err := dropIn.ToString()
+	if err != nil {
+		return err
+	}
+	return t.writeQuadletDropIn(ctx, []byte(data))
+}
+
+// writeQuadletDropIn writes the auto-update drop-in of the Quadlet file of the
+// task, or removes it if data is nil, and reloads systemd.
+func (t *task) writeQuadletDropIn(ctx context.Context, data []byte) error {
+	path := quadletDropInPath(t.quadletFile)
+	if data == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := ioutils.AtomicWriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+	}
+	return t.auto.conn.ReloadContext(ctx)
+}
+
+// highestMatchingTag returns the tag with the highest semantic version
+// matching the constraint.  Tags which are no semantic versions are ignored.
+func highestMatchingTag(tags []string, constraint *semver.Constraints) (string, error) {
+	var (
+		bestTag     string
+		bestVersion *semver.Version
+	)
+	for _, tag := range tags {
+		version, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if !constraint.Check(version) {
+			continue
+		}
+		if bestVersion == nil || version.GreaterThan(bestVersion) {
+			bestTag, bestVersion = tag, version
+		}
+	}
+	if bestVersion == nil {
+		return "", fmt.Errorf("no tag matching version constraint %q", constraint.String())
+	}
+	return bestTag, nil
+}
+
 // localUpdateAvailable returns whether a new image in the local storage is available.
 func (t *task) localUpdateAvailable() (bool, error) {
 	localImg, _, err := t.auto.runtime.LibimageRuntime().LookupImage(t.rawImageName, nil)
@@ -320,7 +776,12 @@ func (t *task) localUpdateAvailable() (bool, error) {
 }
 
 // rollbackImage rolls back the task's image to the previous version before the update.
-func (t *task) rollbackImage() error {
+func (t *task) rollbackImage(ctx context.Context) error {
+	// Restore the drop-in the semver policy has written.
+	if t.quadletFile != "" {
+		return t.writeQuadletDropIn(ctx, t.quadletOld)
+	}
+
 	// To fallback, simply retag the old image and restart the service.
 	if err := t.image.Tag(t.rawImageName); err != nil {
 		return err
@@ -431,6 +892,15 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 		if fromContainer, ok := labels[define.AutoUpdateAuthfileLabel]; ok {
 			authfile = fromContainer
 		}
+		var constraint *semver.Constraints
+		if policy == PolicySemverImage {
+			constraint, err = semver.NewConstraint(labels[define.AutoUpdateSemverLabel])
+			if err != nil {
+				errs = append(errs, fmt.Errorf("auto-updating container %q: invalid %s label: %w", ctr.ID(), define.AutoUpdateSemverLabel, err))
+				continue
+			}
+		}
+
 		t := task{
 			authfile:     authfile,
 			auto:         u,
@@ -439,6 +909,7 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 			image:        image,
 			unit:         unit,
 			rawImageName: rawImageName,
+			semver:       constraint,
 			status:       statusFailed, // must be updated later on
 		}
 
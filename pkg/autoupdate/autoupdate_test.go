@@ -0,0 +1,40 @@
+//go:build !remote
+
+package autoupdate
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighestMatchingTag(t *testing.T) {
+	tags := []string{"latest", "1.3.9", "1.4.0", "1.4.2", "v1.4.10", "1.4.11-rc1", "1.5.0", "2.0.0", "stable"}
+	tests := []struct {
+		constraint string
+		expected   string
+		expectErr  bool
+	}{
+		{constraint: "~1.4", expected: "v1.4.10"},
+		{constraint: "^1.3", expected: "1.5.0"},
+		{constraint: ">=1.3 <1.4", expected: "1.3.9"},
+		{constraint: "1.4.x", expected: "v1.4.10"},
+		{constraint: ">=2", expected: "2.0.0"},
+		{constraint: "~3.0", expectErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.constraint, func(t *testing.T) {
+			constraint, err := semver.NewConstraint(test.constraint)
+			require.NoError(t, err)
+			tag, err := highestMatchingTag(tags, constraint)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, tag)
+		})
+	}
+}
@@ -65,6 +65,7 @@ type AddOptions struct {
 	Append           *bool
 	FileMIMEType     *string
 	Replace          *bool
+	ReplaceFile      *bool
 }
 
 // ExtractOptions
@@ -91,3 +91,18 @@ func (o *AddOptions) GetReplace() bool {
 	}
 	return *o.Replace
 }
+
+// WithReplaceFile set field ReplaceFile to given value
+func (o *AddOptions) WithReplaceFile(value bool) *AddOptions {
+	o.ReplaceFile = &value
+	return o
+}
+
+// GetReplaceFile returns value of field ReplaceFile
+func (o *AddOptions) GetReplaceFile() bool {
+	if o.ReplaceFile == nil {
+		var z bool
+		return z
+	}
+	return *o.ReplaceFile
+}
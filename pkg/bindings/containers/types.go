@@ -12,7 +12,9 @@ import (
 //
 //go:generate go run ../generator/generator.go LogOptions
 type LogOptions struct {
+	Filters    map[string][]string
 	Follow     *bool
+	Grep       *string
 	Since      *string
 	Stderr     *bool
 	Stdout     *bool
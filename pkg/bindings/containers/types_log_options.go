@@ -17,6 +17,21 @@ func (o *LogOptions) ToParams() (url.Values, error) {
 	return util.ToParams(o)
 }
 
+// WithFilters set field Filters to given value
+func (o *LogOptions) WithFilters(value map[string][]string) *LogOptions {
+	o.Filters = value
+	return o
+}
+
+// GetFilters returns value of field Filters
+func (o *LogOptions) GetFilters() map[string][]string {
+	if o.Filters == nil {
+		var z map[string][]string
+		return z
+	}
+	return o.Filters
+}
+
 // WithFollow set field Follow to given value
 func (o *LogOptions) WithFollow(value bool) *LogOptions {
 	o.Follow = &value
@@ -32,6 +47,21 @@ func (o *LogOptions) GetFollow() bool {
 	return *o.Follow
 }
 
+// WithGrep set field Grep to given value
+func (o *LogOptions) WithGrep(value string) *LogOptions {
+	o.Grep = &value
+	return o
+}
+
+// GetGrep returns value of field Grep
+func (o *LogOptions) GetGrep() string {
+	if o.Grep == nil {
+		var z string
+		return z
+	}
+	return *o.Grep
+}
+
 // WithSince set field Since to given value
 func (o *LogOptions) WithSince(value string) *LogOptions {
 	o.Since = &value
@@ -64,6 +64,9 @@ type PlayOptions struct {
 	Wait             *bool
 	ServiceContainer *bool
 	NoPodPrefix      *bool
+	// ReadyTimeout - seconds to wait for a replica of a StatefulSet to
+	// become ready before the next one is started
+	ReadyTimeout *uint
 }
 
 // ApplyOptions are optional options for applying kube YAML files to a k8s cluster
@@ -422,3 +422,18 @@ func (o *PlayOptions) GetNoPodPrefix() bool {
 	}
 	return *o.NoPodPrefix
 }
+
+// WithReadyTimeout set field ReadyTimeout to given value
+func (o *PlayOptions) WithReadyTimeout(value uint) *PlayOptions {
+	o.ReadyTimeout = &value
+	return o
+}
+
+// GetReadyTimeout returns value of field ReadyTimeout
+func (o *PlayOptions) GetReadyTimeout() uint {
+	if o.ReadyTimeout == nil {
+		var z uint
+		return z
+	}
+	return *o.ReadyTimeout
+}
@@ -0,0 +1,52 @@
+package pods
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v6/pkg/api/handlers"
+	"github.com/containers/podman/v6/pkg/bindings"
+	"github.com/containers/podman/v6/pkg/domain/entities/types"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Update updates the resource limits, restart policy and labels of a pod
+func Update(ctx context.Context, options *types.PodUpdateOptions) (string, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	if options.RestartPolicy != nil {
+		params.Set("restartPolicy", *options.RestartPolicy)
+		if options.RestartRetries != nil {
+			params.Set("restartRetries", strconv.Itoa(int(*options.RestartRetries)))
+		}
+	}
+
+	updateEntities := &handlers.PodUpdateEntities{
+		Labels:      options.Labels,
+		UnsetLabels: options.UnsetLabels,
+	}
+	if options.Resources != nil {
+		updateEntities.LinuxResources = *options.Resources
+	}
+
+	requestData, err := jsoniter.MarshalToString(updateEntities)
+	if err != nil {
+		return "", err
+	}
+	stringReader := strings.NewReader(requestData)
+	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/pods/%s/update", params, nil, options.NameOrID)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	var id string
+	return id, response.Process(&id)
+}
@@ -30,3 +30,11 @@ type CreateOptions struct {
 	Replace    *bool
 	Ignore     *bool
 }
+
+// UpdateOptions are optional options for updating secrets
+//
+//go:generate go run ../generator/generator.go UpdateOptions
+type UpdateOptions struct {
+	Restart *bool
+	Signal  *string
+}
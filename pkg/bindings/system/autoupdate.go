@@ -0,0 +1,55 @@
+package system
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/containers/podman/v6/pkg/api/handlers"
+	"github.com/containers/podman/v6/pkg/auth"
+	"github.com/containers/podman/v6/pkg/bindings"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	imageTypes "go.podman.io/image/v5/types"
+)
+
+// AutoUpdate updates the containers configured for auto updates according to
+// their auto-update policy.  It returns the reports of the containers and the
+// errors of failed updates, which do not prevent other containers from being
+// updated.
+func AutoUpdate(ctx context.Context, options *AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
+	if options == nil {
+		options = new(AutoUpdateOptions)
+	}
+	var report handlers.LibpodAutoUpdateReport
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, []error{err}
+	}
+	header, err := auth.MakeXRegistryAuthHeader(&imageTypes.SystemContext{AuthFilePath: options.GetAuthfile()}, "", "")
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, []error{err}
+	}
+	// SkipTLSVerify is special.  It's not being serialized by ToParams()
+	// because we need to flip the boolean.
+	if options.SkipTLSVerify != nil {
+		params.Set("tlsVerify", strconv.FormatBool(!options.GetSkipTLSVerify()))
+	}
+
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/autoupdate", params, header)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer response.Body.Close()
+
+	if err := response.Process(&report); err != nil {
+		return nil, []error{err}
+	}
+
+	return report.Reports, errorhandling.StringsToErrors(report.Errors)
+}
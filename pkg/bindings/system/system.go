@@ -59,6 +59,52 @@ func Events(ctx context.Context, eventChan chan types.Event, cancelChan chan boo
 	return nil
 }
 
+// Monitor streams periodic samples of host and per-container resource usage.
+// The samples are passed to the reportChan provided. The optional cancelChan
+// can be used to stop the stream and close down the HTTP connection.
+func Monitor(ctx context.Context, reportChan chan types.MonitorReport, cancelChan chan bool, options *MonitorOptions) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/monitor", params, nil)
+	if err != nil {
+		return err
+	}
+
+	if cancelChan != nil {
+		go func() {
+			<-cancelChan
+			if err := response.Body.Close(); err != nil {
+				logrus.Errorf("Unable to close monitor response body: %v", err)
+			}
+		}()
+	}
+
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+		return response.Process(nil)
+	}
+
+	go func() {
+		defer response.Body.Close()
+		defer close(reportChan)
+		dec := json.NewDecoder(response.Body)
+		for err = (error)(nil); err == nil; {
+			r := types.MonitorReport{}
+			err = dec.Decode(&r)
+			if err == nil {
+				reportChan <- r
+			}
+		}
+	}()
+	return nil
+}
+
 // Prune removes all unused system data.
 func Prune(ctx context.Context, options *PruneOptions) (*types.SystemPruneReport, error) {
 	var report types.SystemPruneReport
@@ -155,12 +201,15 @@ func DiskUsage(ctx context.Context, options *DiskOptions) (*types.SystemDfReport
 	if options == nil {
 		options = new(DiskOptions)
 	}
-	_ = options
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/system/df", nil, nil)
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/system/df", params, nil)
 	if err != nil {
 		return nil, err
 	}
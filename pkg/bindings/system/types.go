@@ -29,7 +29,9 @@ type VersionOptions struct{}
 // DiskOptions are optional options for getting storage consumption
 //
 //go:generate go run ../generator/generator.go DiskOptions
-type DiskOptions struct{}
+type DiskOptions struct {
+	Verbose *bool
+}
 
 // InfoOptions are optional options for getting info
 // about libpod
@@ -46,3 +48,39 @@ type CheckOptions struct {
 	RepairLossy                 *bool   `schema:"repair_lossy"`
 	UnreferencedLayerMaximumAge *string `schema:"unreferenced_layer_max_age"`
 }
+
+// MonitorOptions are optional options for monitoring system resources
+//
+//go:generate go run ../generator/generator.go MonitorOptions
+type MonitorOptions struct {
+	Filters  map[string][]string
+	Interval *int
+	Stream   *bool
+}
+
+// AutoUpdateOptions are optional options for auto-updating containers
+//
+//go:generate go run ../generator/generator.go AutoUpdateOptions
+type AutoUpdateOptions struct {
+	// Authfile is the path to the authentication file used to contact
+	// registries.  Its credentials are sent to the server in the
+	// X-Registry-Auth header.
+	Authfile *string `schema:"-"`
+	// DryRun only checks for but does not perform any update.
+	DryRun *bool `schema:"dryRun"`
+	// Rollback to the previous image if the update fails.
+	Rollback *bool `schema:"rollback"`
+	// SkipTLSVerify to skip HTTPS and certificate verification.
+	SkipTLSVerify *bool `schema:"-"`
+	// WaitHealthy is the number of seconds to wait for updated containers
+	// with a healthcheck to become healthy.
+	WaitHealthy *uint `schema:"waitHealthy"`
+	// Canary updates a single unit first in a staged rollout.
+	Canary *bool `schema:"canary"`
+	// BatchSize is the number of units updated per stage of a staged
+	// rollout.
+	BatchSize *uint `schema:"batchSize"`
+	// SoakTime is the number of seconds the units of a rollout stage must
+	// keep running before the next stage is started.
+	SoakTime *uint `schema:"soakTime"`
+}
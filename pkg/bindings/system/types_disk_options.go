@@ -16,3 +16,18 @@ func (o *DiskOptions) Changed(fieldName string) bool {
 func (o *DiskOptions) ToParams() (url.Values, error) {
 	return util.ToParams(o)
 }
+
+// WithVerbose set field Verbose to given value
+func (o *DiskOptions) WithVerbose(value bool) *DiskOptions {
+	o.Verbose = &value
+	return o
+}
+
+// GetVerbose returns value of field Verbose
+func (o *DiskOptions) GetVerbose() bool {
+	if o.Verbose == nil {
+		var z bool
+		return z
+	}
+	return *o.Verbose
+}
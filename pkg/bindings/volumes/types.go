@@ -40,3 +40,32 @@ type RemoveOptions struct {
 //
 //go:generate go run ../generator/generator.go ExistsOptions
 type ExistsOptions struct{}
+
+// SnapshotOptions are optional options for taking a snapshot of a volume
+//
+//go:generate go run ../generator/generator.go SnapshotOptions
+type SnapshotOptions struct {
+	// Name of the snapshot
+	Name *string
+	// Force taking the snapshot of a volume used by a running container
+	Force *bool
+}
+
+// CloneOptions are optional options for cloning a volume
+//
+//go:generate go run ../generator/generator.go CloneOptions
+type CloneOptions struct {
+	// Name of the clone
+	Name *string
+	// Force cloning a volume used by a running container
+	Force *bool
+}
+
+// RollbackOptions are optional options for rolling a volume back to a
+// snapshot
+//
+//go:generate go run ../generator/generator.go RollbackOptions
+type RollbackOptions struct {
+	// Snapshot to restore, the most recent one by default
+	Snapshot *string
+}
@@ -169,3 +169,74 @@ func Import(ctx context.Context, nameOrID string, importFrom io.Reader) error {
 
 	return response.Process(nil)
 }
+
+// Snapshot creates a new volume with a copy of the contents of the given
+// volume, which the volume can be rolled back to.
+func Snapshot(ctx context.Context, nameOrID string, options *SnapshotOptions) (*entitiesTypes.VolumeConfigResponse, error) {
+	var v entitiesTypes.VolumeConfigResponse
+	if options == nil {
+		options = new(SnapshotOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/volumes/%s/snapshot", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &v, response.Process(&v)
+}
+
+// Clone creates a new volume with a copy of the contents of the given volume.
+func Clone(ctx context.Context, nameOrID string, options *CloneOptions) (*entitiesTypes.VolumeConfigResponse, error) {
+	var v entitiesTypes.VolumeConfigResponse
+	if options == nil {
+		options = new(CloneOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/volumes/%s/clone", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &v, response.Process(&v)
+}
+
+// Rollback replaces the contents of the given volume with the contents of one
+// of its snapshots. The snapshot which was restored is returned.
+func Rollback(ctx context.Context, nameOrID string, options *RollbackOptions) (*entitiesTypes.VolumeConfigResponse, error) {
+	var v entitiesTypes.VolumeConfigResponse
+	if options == nil {
+		options = new(RollbackOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/volumes/%s/rollback", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &v, response.Process(&v)
+}
@@ -0,0 +1,303 @@
+//go:build !remote
+
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	metadata "github.com/checkpoint-restore/checkpointctl/lib"
+	"github.com/containers/podman/v6/libpod"
+	ann "github.com/containers/podman/v6/pkg/annotations"
+	"github.com/containers/podman/v6/pkg/checkpoint/crutils"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/libimage"
+	"go.podman.io/common/pkg/config"
+	"go.podman.io/storage/pkg/archive"
+	"go.podman.io/storage/pkg/stringid"
+)
+
+const (
+	// podConfigDumpFile holds the configuration of the checkpointed pod.
+	podConfigDumpFile = "pod.dump"
+	// podInfraConfigDumpFile holds the configuration of the pod's infra
+	// container, which is recreated instead of being checkpointed.
+	podInfraConfigDumpFile = "infra.dump"
+	// podContainersDirectory holds the exported checkpoint of each
+	// container of the pod, named after the container's ID.
+	podContainersDirectory = "containers"
+)
+
+// CRExportPodCheckpoint checkpoints all running containers of the pod at once
+// and writes their checkpoints along with the configuration of the pod and
+// its infra container into a single archive.  The IDs of the checkpointed
+// containers are returned.
+func CRExportPodCheckpoint(ctx context.Context, pod *libpod.Pod, options entities.PodCheckpointOptions) (_ []string, retErr error) {
+	dir, err := os.MkdirTemp("", "pod-checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Could not recursively remove %s: %q", dir, err)
+		}
+	}()
+	ctrsDir := filepath.Join(dir, podContainersDirectory)
+	if err := os.Mkdir(ctrsDir, 0o700); err != nil {
+		return nil, err
+	}
+
+	// Create the archive before checkpointing, so the pod is not stopped
+	// if it cannot be written.
+	output, err := os.Create(options.Export)
+	if err != nil {
+		return nil, fmt.Errorf("creating checkpoint export file %q: %w", options.Export, err)
+	}
+	defer func() {
+		output.Close()
+		if retErr != nil {
+			os.Remove(options.Export)
+		}
+	}()
+
+	podConfig, err := pod.Config()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := metadata.WriteJSONFile(podConfig, dir, podConfigDumpFile); err != nil {
+		return nil, err
+	}
+	if pod.HasInfraContainer() {
+		infra, err := pod.InfraContainer()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := metadata.WriteJSONFile(infra.Config(), dir, podInfraConfigDumpFile); err != nil {
+			return nil, err
+		}
+	}
+
+	// The checkpoints of the containers are compressed along with the
+	// whole archive.
+	checkpointOptions := libpod.ContainerCheckpointOptions{
+		KeepRunning:    options.LeaveRunning,
+		TCPEstablished: options.TCPEstablished,
+		FileLocks:      options.FileLocks,
+		IgnoreRootfs:   options.IgnoreRootFS,
+		IgnoreVolumes:  options.IgnoreVolumes,
+		Compression:    archive.Uncompressed,
+	}
+	ids, err := pod.Checkpoint(ctx, ctrsDir, checkpointOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := archive.TarWithOptions(dir, &archive.TarOptions{
+		Compression: options.Compression,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading checkpoint directory %q: %w", dir, err)
+	}
+	defer input.Close()
+	if _, err := io.Copy(output, input); err != nil {
+		return nil, fmt.Errorf("writing checkpoint export file %q: %w", options.Export, err)
+	}
+	return ids, nil
+}
+
+// CRImportPodCheckpoint recreates a pod from an archive written by
+// CRExportPodCheckpoint and restores all containers of the pod from their
+// checkpoints.  If a new name is requested, the pod and its containers get
+// new IDs and the containers are renamed after the pod.  If any container
+// cannot be restored, the pod is removed again.
+func CRImportPodCheckpoint(ctx context.Context, runtime *libpod.Runtime, options entities.PodRestoreOptions) (_ *libpod.Pod, _ []string, retErr error) {
+	dir, err := os.MkdirTemp("", "pod-checkpoint")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Could not recursively remove %s: %q", dir, err)
+		}
+	}()
+
+	archiveFile, err := os.Open(options.Import)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open pod checkpoint archive %s for import: %w", options.Import, err)
+	}
+	defer archiveFile.Close()
+	if err := archive.Untar(archiveFile, dir, nil); err != nil {
+		return nil, nil, fmt.Errorf("unpacking of pod checkpoint archive %s failed: %w", options.Import, err)
+	}
+
+	podConfig := new(libpod.PodConfig)
+	if _, err := metadata.ReadJSONFile(podConfig, dir, podConfigDumpFile); err != nil {
+		return nil, nil, fmt.Errorf("%s is not a pod checkpoint archive: %w", options.Import, err)
+	}
+	oldPodName := podConfig.Name
+
+	// Read the configuration of all containers first, so nothing is
+	// created if any of their volumes exists already.
+	ctrArchives, err := filepath.Glob(filepath.Join(dir, podContainersDirectory, "*.tar"))
+	if err != nil {
+		return nil, nil, err
+	}
+	ctrConfigs := make([]*libpod.ContainerConfig, 0, len(ctrArchives))
+	for _, ctrArchive := range ctrArchives {
+		ctrDir := strings.TrimSuffix(ctrArchive, ".tar")
+		if err := crutils.CRImportCheckpointConfigOnly(ctrDir, ctrArchive); err != nil {
+			return nil, nil, err
+		}
+		ctrConfig := new(libpod.ContainerConfig)
+		if _, err := metadata.ReadJSONFile(ctrConfig, ctrDir, metadata.ConfigDumpFile); err != nil {
+			return nil, nil, err
+		}
+		if !options.IgnoreVolumes {
+			for _, vol := range ctrConfig.NamedVolumes {
+				exists, err := runtime.HasVolume(vol.Name)
+				if err != nil {
+					return nil, nil, err
+				}
+				if exists {
+					return nil, nil, fmt.Errorf("volume with name %s already exists. Use --ignore-volumes to not restore content of volumes", vol.Name)
+				}
+			}
+		}
+		ctrConfigs = append(ctrConfigs, ctrConfig)
+	}
+
+	if options.Name != "" {
+		podConfig.ID = stringid.GenerateRandomID()
+		podConfig.Name = options.Name
+	}
+	pod, err := runtime.RestorePod(ctx, podConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			if _, err := runtime.RemovePod(ctx, pod, true, true, nil); err != nil {
+				logrus.Errorf("Removing pod %s after failed restore: %v", pod.ID(), err)
+			}
+		}
+	}()
+
+	if podConfig.HasInfra {
+		if err := crRestorePodInfra(ctx, runtime, pod, dir, options); err != nil {
+			return nil, nil, fmt.Errorf("restoring infra container: %w", err)
+		}
+	}
+
+	restoreOptions := libpod.ContainerCheckpointOptions{
+		Pod:             pod.ID(),
+		TCPEstablished:  options.TCPEstablished,
+		FileLocks:       options.FileLocks,
+		IgnoreRootfs:    options.IgnoreRootFS,
+		IgnoreVolumes:   options.IgnoreVolumes,
+		IgnoreStaticIP:  options.IgnoreStaticIP,
+		IgnoreStaticMAC: options.IgnoreStaticMAC,
+	}
+	ids := make([]string, 0, len(ctrArchives))
+	for i, ctrArchive := range ctrArchives {
+		importOptions := entities.RestoreOptions{
+			Import:          ctrArchive,
+			Pod:             pod.ID(),
+			IgnoreRootFS:    options.IgnoreRootFS,
+			IgnoreStaticIP:  options.IgnoreStaticIP,
+			IgnoreStaticMAC: options.IgnoreStaticMAC,
+			TCPEstablished:  options.TCPEstablished,
+			FileLocks:       options.FileLocks,
+			// Volumes shared by containers of the pod are part of
+			// the checkpoint of each of them and have been checked
+			// for all containers above.
+			IgnoreVolumes: true,
+		}
+		if options.Name != "" {
+			importOptions.Name = crPodContainerName(ctrConfigs[i].Name, oldPodName, options.Name)
+		}
+		ctrs, err := CRImportCheckpoint(ctx, runtime, importOptions, strings.TrimSuffix(ctrArchive, ".tar"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("importing container %s: %w", ctrConfigs[i].Name, err)
+		}
+		if len(ctrs) == 0 {
+			continue
+		}
+		restoreOptions.TargetFile = ctrArchive
+		if _, _, err := ctrs[0].Restore(ctx, restoreOptions); err != nil {
+			return nil, nil, fmt.Errorf("restoring container %s: %w", ctrs[0].Name(), err)
+		}
+		ids = append(ids, ctrs[0].ID())
+	}
+	return pod, ids, nil
+}
+
+// crRestorePodInfra recreates the infra container of a restored pod from
+// its configuration in the pod checkpoint directory.
+func crRestorePodInfra(ctx context.Context, runtime *libpod.Runtime, pod *libpod.Pod, dir string, options entities.PodRestoreOptions) error {
+	infraConfig := new(libpod.ContainerConfig)
+	if _, err := metadata.ReadJSONFile(infraConfig, dir, podInfraConfigDumpFile); err != nil {
+		return err
+	}
+	if infraConfig.Spec == nil {
+		return errors.New("missing runtime spec of infra container")
+	}
+
+	if infraConfig.Pod != pod.ID() {
+		infraConfig.ID = ""
+		infraConfig.Name = pod.ID()[:12] + "-infra"
+		infraConfig.Pod = pod.ID()
+		if _, ok := infraConfig.Spec.Annotations[ann.SandboxID]; ok {
+			infraConfig.Spec.Annotations[ann.SandboxID] = pod.ID()
+		}
+	}
+	cgroupPath, err := pod.CgroupPath()
+	if err != nil {
+		return err
+	}
+	if cgroupPath != "" {
+		infraConfig.CgroupParent = cgroupPath
+	}
+	for net, opts := range infraConfig.Networks {
+		if options.IgnoreStaticIP {
+			opts.StaticIPs = nil
+		}
+		if options.IgnoreStaticMAC {
+			opts.StaticMAC = nil
+		}
+		infraConfig.Networks[net] = opts
+	}
+
+	if infraConfig.RootfsImageName != "" {
+		pullOptions := &libimage.PullOptions{}
+		pullOptions.Writer = os.Stderr
+		pulled, err := runtime.LibimageRuntime().Pull(ctx, infraConfig.RootfsImageName, config.PullPolicyMissing, pullOptions)
+		if err != nil {
+			return err
+		}
+		infraConfig.RootfsImageID = pulled[0].ID()
+	}
+
+	infra, err := runtime.RestoreContainer(ctx, infraConfig.Spec, infraConfig)
+	if err != nil {
+		return err
+	}
+	_, err = runtime.AddInfra(ctx, pod, infra)
+	return err
+}
+
+// crPodContainerName returns the name of a container of a pod restored under
+// a new name.  Container names starting with the name of the pod, as
+// generated by kube play, get the new name of the pod as prefix instead;
+// other names are prefixed with the new name of the pod.
+func crPodContainerName(name, oldPodName, newPodName string) string {
+	if suffix, ok := strings.CutPrefix(name, oldPodName+"-"); ok {
+		return newPodName + "-" + suffix
+	}
+	return newPodName + "-" + name
+}
@@ -15,6 +15,9 @@ type ArtifactAddOptions struct {
 	Append           bool
 	FileMIMEType     string
 	Replace          bool
+	// ReplaceFile replaces the files with the same name in an existing
+	// artifact and keeps all other files.
+	ReplaceFile bool
 }
 
 type ArtifactAddReport = entitiesTypes.ArtifactAddReport
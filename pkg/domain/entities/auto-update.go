@@ -1,6 +1,10 @@
 package entities
 
-import "go.podman.io/image/v5/types"
+import (
+	"time"
+
+	"go.podman.io/image/v5/types"
+)
 
 // AutoUpdateOptions are the options for running auto-update.
 type AutoUpdateOptions struct {
@@ -13,6 +17,20 @@ type AutoUpdateOptions struct {
 	// If restarting the service with the new image failed, restart it
 	// another time with the previous image.
 	Rollback bool
+	// Wait up to the specified duration for restarted containers with a
+	// healthcheck to become healthy.  If they do not, the update is
+	// considered to have failed.  Zero disables waiting.
+	WaitHealthy time.Duration
+	// Update the units in stages: first a single canary unit, then in
+	// batches of BatchSize units (see BatchSize and SoakTime).
+	Canary bool
+	// Number of units updated per stage of a staged rollout.  Zero updates
+	// all remaining units in a single stage.
+	BatchSize int
+	// Time the units of a rollout stage must keep running, and their
+	// containers healthy, before the next stage is started.  If they do
+	// not, the rollout is aborted.
+	SoakTime time.Duration
 	// Allow contacting registries over HTTP, or HTTPS with failed TLS
 	// verification. Note that this does not affect other TLS connections.
 	InsecureSkipTLSVerify types.OptionalBool
@@ -31,6 +49,13 @@ type AutoUpdateReport struct {
 	// SystemdUnit running a container configured for auto updates.
 	SystemdUnit string
 	// Indicates the update status: true, false, failed, pending (see
-	// DryRun).
+	// DryRun), rolled back or aborted (see Canary).
 	Updated string
+	// Stage of a staged rollout in which the unit has been updated,
+	// starting at 1.  Zero if the unit has not been updated in a staged
+	// rollout.
+	Stage int
+	// Health status of the updated container if waiting for it to become
+	// healthy (see WaitHealthy).
+	Health string
 }
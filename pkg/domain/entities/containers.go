@@ -10,6 +10,7 @@ import (
 	"github.com/containers/podman/v6/pkg/domain/entities/types"
 	"github.com/containers/podman/v6/pkg/specgen"
 	nettypes "go.podman.io/common/libnetwork/types"
+	"go.podman.io/common/pkg/ssh"
 	imageTypes "go.podman.io/image/v5/types"
 	"go.podman.io/storage/pkg/archive"
 )
@@ -233,10 +234,37 @@ type RestoreOptions struct {
 	Pod             string
 	PrintStats      bool
 	FileLocks       bool
+	// ImportPreviousChain are the archives of further chained
+	// pre-checkpoints, imported in the given order after ImportPrevious.
+	ImportPreviousChain []string
 }
 
 type RestoreReport = types.RestoreReport
 
+// ContainerMigrateOptions describes the options for migrating a container
+// to the host of a system connection.
+type ContainerMigrateOptions struct {
+	// PreDumps is the number of chained pre-checkpoints transferred
+	// while the container keeps running.
+	PreDumps        int
+	IgnoreRootFS    bool
+	IgnoreVolumes   bool
+	IgnoreStaticIP  bool
+	IgnoreStaticMAC bool
+	TCPEstablished  bool
+	FileLocks       bool
+	// SSHMode is the specified ssh.EngineMode which should be used
+	SSHMode ssh.EngineMode
+}
+
+// ContainerMigrateReport describes the migrated container.
+type ContainerMigrateReport struct {
+	// Id of the removed local container
+	Id string
+	// RemoteId is the ID of the container restored on the remote host
+	RemoteId string
+}
+
 type ContainerCreateReport struct {
 	Id string
 }
@@ -273,6 +301,10 @@ type ContainerLogsOptions struct {
 	Timestamps bool
 	// Show different colors in the logs.
 	Colors bool
+	// Only show log lines whose message matches this regular expression.
+	Grep string
+	// Only show log lines whose fields match these filters.
+	Filters map[string][]string
 	// Write the stdout to this Writer.
 	StdoutWriter io.Writer
 	// Write the stderr to this Writer.
@@ -402,6 +434,22 @@ type ContainerInitReport struct {
 	RawInput string
 }
 
+// ContainerRotateLogsOptions describes input options
+// for the container rotate-logs cli
+type ContainerRotateLogsOptions struct {
+	All    bool
+	Latest bool
+}
+
+// ContainerRotateLogsReport describes the results of
+// rotating the log file of a container
+type ContainerRotateLogsReport struct {
+	Err      error
+	Id       string
+	RawInput string
+	Rotated  bool
+}
+
 // ContainerMountOptions describes the input values for mounting containers
 // in the CLI
 type ContainerMountOptions struct {
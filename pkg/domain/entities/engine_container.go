@@ -36,6 +36,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	ContainerList(ctx context.Context, options ContainerListOptions) ([]ListContainer, error)
 	ContainerListExternal(ctx context.Context) ([]ListContainer, error)
 	ContainerLogs(ctx context.Context, containers []string, options ContainerLogsOptions) error
+	ContainerMigrate(ctx context.Context, nameOrID, connection string, options ContainerMigrateOptions) (*ContainerMigrateReport, error)
 	ContainerMount(ctx context.Context, nameOrIDs []string, options ContainerMountOptions) ([]*ContainerMountReport, error)
 	ContainerPause(ctx context.Context, namesOrIds []string, options PauseUnPauseOptions) ([]*PauseUnpauseReport, error)
 	ContainerPort(ctx context.Context, nameOrID string, options ContainerPortOptions) ([]*ContainerPortReport, error)
@@ -44,6 +45,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	ContainerRestart(ctx context.Context, namesOrIds []string, options RestartOptions) ([]*RestartReport, error)
 	ContainerRestore(ctx context.Context, namesOrIds []string, options RestoreOptions) ([]*RestoreReport, error)
 	ContainerRm(ctx context.Context, namesOrIds []string, options RmOptions) ([]*reports.RmReport, error)
+	ContainerRotateLogs(ctx context.Context, namesOrIds []string, options ContainerRotateLogsOptions) ([]*ContainerRotateLogsReport, error)
 	ContainerRun(ctx context.Context, opts ContainerRunOptions) (*ContainerRunReport, error)
 	ContainerRunlabel(ctx context.Context, label string, image string, args []string, opts ContainerRunlabelOptions) error
 	ContainerStart(ctx context.Context, namesOrIds []string, options ContainerStartOptions) ([]*ContainerStartReport, error)
@@ -78,7 +80,9 @@ type ContainerEngine interface { //nolint:interfacebloat
 	NetworkRm(ctx context.Context, namesOrIds []string, options NetworkRmOptions) ([]*NetworkRmReport, error)
 	PlayKube(ctx context.Context, body io.Reader, opts PlayKubeOptions) (*PlayKubeReport, error)
 	PlayKubeDown(ctx context.Context, body io.Reader, opts PlayKubeDownOptions) (*PlayKubeReport, error)
+	KubeCronJobRun(ctx context.Context, name string, opts KubeCronJobRunOptions) error
 	PodCreate(ctx context.Context, specg PodSpec) (*PodCreateReport, error)
+	PodCheckpoint(ctx context.Context, nameOrID string, options PodCheckpointOptions) (*PodCheckpointReport, error)
 	PodClone(ctx context.Context, podClone PodCloneOptions) (*PodCloneReport, error)
 	PodExists(ctx context.Context, nameOrID string) (*BoolReport, error)
 	PodInspect(ctx context.Context, namesOrID []string, options InspectOptions) ([]*PodInspectReport, []error, error)
@@ -88,12 +92,14 @@ type ContainerEngine interface { //nolint:interfacebloat
 	PodPrune(ctx context.Context, options PodPruneOptions) ([]*PodPruneReport, error)
 	PodPs(ctx context.Context, options PodPSOptions) ([]*ListPodsReport, error)
 	PodRestart(ctx context.Context, namesOrIds []string, options PodRestartOptions) ([]*PodRestartReport, error)
+	PodRestore(ctx context.Context, options PodRestoreOptions) (*PodRestoreReport, error)
 	PodRm(ctx context.Context, namesOrIds []string, options PodRmOptions) ([]*PodRmReport, error)
 	PodStart(ctx context.Context, namesOrIds []string, options PodStartOptions) ([]*PodStartReport, error)
 	PodStats(ctx context.Context, namesOrIds []string, options PodStatsOptions) ([]*PodStatsReport, error)
 	PodStop(ctx context.Context, namesOrIds []string, options PodStopOptions) ([]*PodStopReport, error)
 	PodTop(ctx context.Context, options PodTopOptions) (*StringSliceReport, error)
 	PodUnpause(ctx context.Context, namesOrIds []string, options PodunpauseOptions) ([]*PodUnpauseReport, error)
+	PodUpdate(ctx context.Context, options *PodUpdateOptions) (string, error)
 	QuadletInstall(ctx context.Context, pathsOrURLs []string, options QuadletInstallOptions) (*QuadletInstallReport, error)
 	QuadletList(ctx context.Context, options QuadletListOptions) ([]*ListQuadlet, error)
 	QuadletPrint(ctx context.Context, quadlet string) (string, error)
@@ -106,9 +112,12 @@ type ContainerEngine interface { //nolint:interfacebloat
 	SecretList(ctx context.Context, opts SecretListRequest) ([]*SecretInfoReport, error)
 	SecretRm(ctx context.Context, nameOrID []string, opts SecretRmOptions) ([]*SecretRmReport, error)
 	SecretExists(ctx context.Context, nameOrID string) (*BoolReport, error)
+	SecretUpdate(ctx context.Context, nameOrID string, reader io.Reader, options SecretUpdateOptions) (*SecretUpdateReport, error)
 	Shutdown(ctx context.Context)
+	SystemBackup(ctx context.Context, options SystemBackupOptions) (*SystemBackupReport, error)
 	SystemDf(ctx context.Context, options SystemDfOptions) (*SystemDfReport, error)
 	SystemCheck(ctx context.Context, options SystemCheckOptions) (*SystemCheckReport, error)
+	SystemRestore(ctx context.Context, options SystemRestoreOptions) (*SystemRestoreReport, error)
 	Unshare(ctx context.Context, args []string, options SystemUnshareOptions) error
 	Version(ctx context.Context) (*SystemVersionReport, error)
 	VolumeCreate(ctx context.Context, opts VolumeCreateOptions) (*IDOrNameResponse, error)
@@ -123,4 +132,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	VolumeReload(ctx context.Context) (*VolumeReloadReport, error)
 	VolumeExport(ctx context.Context, nameOrID string, options VolumeExportOptions) error
 	VolumeImport(ctx context.Context, nameOrID string, options VolumeImportOptions) error
+	VolumeSnapshot(ctx context.Context, nameOrID string, options VolumeSnapshotOptions) (*IDOrNameResponse, error)
+	VolumeClone(ctx context.Context, nameOrID string, options VolumeCloneOptions) (*IDOrNameResponse, error)
+	VolumeRollback(ctx context.Context, nameOrID string, options VolumeRollbackOptions) (*IDOrNameResponse, error)
 }
@@ -2,11 +2,16 @@ package entities
 
 import (
 	"net"
+	"time"
 
 	entitiesTypes "github.com/containers/podman/v6/pkg/domain/entities/types"
 	"go.podman.io/image/v5/types"
 )
 
+// DefaultPlayKubeReadyTimeout is the default time to wait for a replica of a
+// StatefulSet to become ready.
+const DefaultPlayKubeReadyTimeout = 5 * time.Minute
+
 // PlayKubeOptions controls playing kube YAML files.
 type PlayKubeOptions struct {
 	// Annotations - Annotations to add to Pods
@@ -83,6 +88,10 @@ type PlayKubeOptions struct {
 	SystemContext *types.SystemContext
 	// Do not prefix container name with pod name
 	NoPodPrefix bool
+	// ReadyTimeout is the maximum time to wait for a replica of a
+	// StatefulSet to become ready before the next one is started.
+	// DefaultPlayKubeReadyTimeout is used if not set.
+	ReadyTimeout time.Duration
 }
 
 // PlayKubePod represents a single pod and associated containers created by play kube
@@ -91,6 +100,24 @@ type PlayKubePod = entitiesTypes.PlayKubePod
 // PlayKubeVolume represents a single volume created by play kube.
 type PlayKubeVolume entitiesTypes.PlayKubeVolume
 
+// PlayKubeCronJob represents a single CronJob scheduled by play kube.
+type PlayKubeCronJob = entitiesTypes.PlayKubeCronJob
+
+// KubeCronJobRunOptions controls a run of a CronJob scheduled by play kube.
+type KubeCronJobRunOptions struct {
+	// ConcurrencyPolicy - Allow, Forbid or Replace concurrent runs.
+	ConcurrencyPolicy string
+	// SuccessfulJobsHistoryLimit - number of successful runs to keep.
+	SuccessfulJobsHistoryLimit int32
+	// FailedJobsHistoryLimit - number of failed runs to keep.
+	FailedJobsHistoryLimit int32
+	// Schedule - if set, keep running the CronJob on this cron schedule
+	// instead of running it once.
+	Schedule string
+	// TimeZone - time zone of Schedule.
+	TimeZone string
+}
+
 // PlayKubeReport contains the results of running play kube.
 type (
 	PlayKubeReport = entitiesTypes.PlayKubeReport
@@ -25,6 +25,15 @@ type SecretListRequest struct {
 
 type SecretListReport = types.SecretListReport
 
+type SecretUpdateOptions struct {
+	// Restart restarts the running containers using the secret
+	Restart bool
+	// Signal is sent to the running containers mounting the secret
+	Signal string
+}
+
+type SecretUpdateReport = types.SecretUpdateReport
+
 type SecretRmOptions struct {
 	All    bool
 	Ignore bool
@@ -63,6 +72,15 @@ type SwagSecretCreateResponse struct {
 	}
 }
 
+// Secret update response
+// swagger:response SecretUpdateResponse
+type SwagSecretUpdateResponse struct {
+	// in:body
+	Body struct {
+		SecretUpdateReport
+	}
+}
+
 // Secret list response
 // swagger:response SecretListResponse
 type SwagSecretListResponse struct {
@@ -6,21 +6,30 @@ import (
 
 // ServiceOptions provides the input for starting an API and sidecar pprof services
 type (
-	ServiceOptions          = types.ServiceOptions
-	SystemPruneOptions      = types.SystemPruneOptions
-	SystemPruneReport       = types.SystemPruneReport
-	SystemMigrateOptions    = types.SystemMigrateOptions
-	SystemCheckOptions      = types.SystemCheckOptions
-	SystemCheckReport       = types.SystemCheckReport
-	SystemDfOptions         = types.SystemDfOptions
-	SystemDfReport          = types.SystemDfReport
-	SystemDfImageReport     = types.SystemDfImageReport
-	SystemDfContainerReport = types.SystemDfContainerReport
-	SystemDfVolumeReport    = types.SystemDfVolumeReport
-	SystemVersionReport     = types.SystemVersionReport
-	SystemUnshareOptions    = types.SystemUnshareOptions
-	ComponentVersion        = types.SystemComponentVersion
-	ListRegistriesReport    = types.ListRegistriesReport
+	ServiceOptions           = types.ServiceOptions
+	SystemPruneOptions       = types.SystemPruneOptions
+	SystemPruneReport        = types.SystemPruneReport
+	SystemMigrateOptions     = types.SystemMigrateOptions
+	SystemBackupOptions      = types.SystemBackupOptions
+	SystemBackupReport       = types.SystemBackupReport
+	SystemRestoreOptions     = types.SystemRestoreOptions
+	SystemRestoreReport      = types.SystemRestoreReport
+	SystemCheckOptions       = types.SystemCheckOptions
+	SystemCheckReport        = types.SystemCheckReport
+	SystemDfOptions          = types.SystemDfOptions
+	SystemDfReport           = types.SystemDfReport
+	SystemDfImageReport      = types.SystemDfImageReport
+	SystemDfContainerReport  = types.SystemDfContainerReport
+	SystemDfVolumeReport     = types.SystemDfVolumeReport
+	SystemDfLayerReport      = types.SystemDfLayerReport
+	SystemDfArtifactReport   = types.SystemDfArtifactReport
+	SystemDfBuildCacheReport = types.SystemDfBuildCacheReport
+	SystemVersionReport      = types.SystemVersionReport
+	SystemUnshareOptions     = types.SystemUnshareOptions
+	ComponentVersion         = types.SystemComponentVersion
+	ListRegistriesReport     = types.ListRegistriesReport
+	MonitorReport            = types.MonitorReport
+	MonitorContainerReport   = types.MonitorContainerReport
 )
 
 type (
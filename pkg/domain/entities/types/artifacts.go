@@ -28,4 +28,7 @@ type ArtifactPushReport struct {
 
 type ArtifactPullReport struct {
 	ArtifactDigest *digest.Digest
+	// Error explains why the artifact was rejected by the signature
+	// policy, e.g. because a required signature is missing or invalid.
+	Error string `json:",omitempty"`
 }
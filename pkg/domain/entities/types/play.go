@@ -19,11 +19,24 @@ type PlayKubeVolume struct {
 	Name string
 }
 
+type PlayKubeCronJob struct {
+	// Name - Name of the CronJob.
+	Name string
+	// Schedule - systemd calendar events the CronJob is scheduled with.
+	Schedule []string
+	// Scheduler - "systemd" or "internal", empty if the CronJob is suspended.
+	Scheduler string
+	// Suspended - the CronJob is suspended and was not scheduled.
+	Suspended bool
+}
+
 type PlayKubeReport struct {
 	// Pods - pods created by play kube.
 	Pods []PlayKubePod
 	// Volumes - volumes created by play kube.
 	Volumes []PlayKubeVolume
+	// CronJobs - CronJobs scheduled by play kube.
+	CronJobs []PlayKubeCronJob
 	PlayKubeTeardown
 	// Secrets - secrets created by play kube
 	Secrets []PlaySecret
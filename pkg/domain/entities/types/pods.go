@@ -5,6 +5,7 @@ import (
 
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/specgen"
+	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 type PodPruneReport struct {
@@ -12,6 +13,21 @@ type PodPruneReport struct {
 	Id  string
 }
 
+// PodUpdateOptions are the options for updating a pod.
+type PodUpdateOptions struct {
+	NameOrID string
+	// Resources are merged into the resource limits of the pod cgroup
+	Resources *specs.LinuxResources
+	// RestartPolicy is the new restart policy of the pod
+	RestartPolicy *string
+	// RestartRetries are the retries of the on-failure restart policy
+	RestartRetries *uint
+	// Labels are added to the pod's labels, replacing labels with the same key
+	Labels map[string]string
+	// UnsetLabels are removed from the pod's labels
+	UnsetLabels []string
+}
+
 type PodPauseReport struct {
 	Errs []error
 	Id   string
@@ -31,6 +31,20 @@ type SecretListReport struct {
 	UpdatedAt string
 }
 
+type SecretUpdateReport struct {
+	// ID is the new ID of the updated secret
+	ID string
+	// Containers are the containers using the secret
+	Containers []string
+	// Restarted are the containers restarted to apply the update
+	Restarted []string
+	// Signaled are the containers signaled about the update
+	Signaled []string
+	// Errors are the errors of updating, restarting or signaling the
+	// containers using the secret, by container ID
+	Errors map[string]string `json:",omitempty"`
+}
+
 type SecretRmReport struct {
 	ID  string
 	Err error
@@ -41,6 +55,7 @@ type SecretInfoReport struct {
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	Spec       SecretSpec
+	Version    SecretVersion
 	SecretData string `json:"SecretData,omitempty"`
 }
 
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"io"
 	"time"
 
 	"github.com/containers/podman/v6/libpod/define"
@@ -65,6 +66,38 @@ type SystemMigrateOptions struct {
 	NewRuntime string
 }
 
+// SystemBackupOptions describes the options for backing up the local engine
+// state into an archive.
+type SystemBackupOptions struct {
+	Output io.Writer // receives the backup archive
+	Images bool      // include all local images
+}
+
+// SystemBackupReport lists the objects written to a backup archive.
+type SystemBackupReport struct {
+	Pods       []string // pod names
+	Containers []string // container names
+	Volumes    []string // volume names
+	Networks   []string // network names
+	Secrets    []string // secret names, only their metadata is backed up
+	Images     []string // image IDs
+}
+
+// SystemRestoreOptions describes the options for restoring the local engine
+// state from a backup archive.
+type SystemRestoreOptions struct {
+	Input io.Reader // provides the backup archive
+}
+
+// SystemRestoreReport lists the objects restored from a backup archive.
+type SystemRestoreReport struct {
+	Pods       []string // pod names
+	Containers []string // container names
+	Volumes    []string // volume names
+	Networks   []string // network names
+	Images     []string // image names or IDs
+}
+
 // SystemDfOptions describes the options for getting df information
 type SystemDfOptions struct {
 	Format  string
@@ -77,6 +110,18 @@ type SystemDfReport struct {
 	Images     []*SystemDfImageReport
 	Containers []*SystemDfContainerReport
 	Volumes    []*SystemDfVolumeReport
+	// Layers are the image layers and the images sharing them.  Only
+	// set in verbose mode.
+	Layers []*SystemDfLayerReport `json:",omitempty"`
+	// ArtifactsSize is the size of all blobs in the artifact store.  Only
+	// set in verbose mode.
+	ArtifactsSize int64 `json:",omitempty"`
+	// Artifacts are the artifacts in the artifact store.  Only set in
+	// verbose mode.
+	Artifacts []*SystemDfArtifactReport `json:",omitempty"`
+	// BuildCache describes the space used by builds.  Only set in
+	// verbose mode.
+	BuildCache *SystemDfBuildCacheReport `json:",omitempty"`
 }
 
 // SystemDfImageReport describes an image for use with df
@@ -112,6 +157,36 @@ type SystemDfVolumeReport struct {
 	ReclaimableSize int64
 }
 
+// SystemDfLayerReport describes an image layer for use with df
+type SystemDfLayerReport struct {
+	LayerID string
+	Size    int64
+	// Images are the IDs of the images using the layer.  The size of the
+	// layer is only reclaimed once all of them are removed.
+	Images []string
+}
+
+// SystemDfArtifactReport describes an artifact for use with df
+type SystemDfArtifactReport struct {
+	Name   string
+	Digest string
+	Size   int64
+	// UniqueSize is the size of the blobs which are not used by any other
+	// artifact, i.e. the size reclaimed when the artifact is removed.
+	UniqueSize int64
+}
+
+// SystemDfBuildCacheReport describes the space used by builds
+type SystemDfBuildCacheReport struct {
+	// IntermediateImages is the number of untagged images with children
+	// created by builds using cached layers.
+	IntermediateImages int
+	// IntermediateSize is the size only used by intermediate images.
+	IntermediateSize int64
+	// CacheMountsSize is the size of the cache mounts of builds.
+	CacheMountsSize int64
+}
+
 // SystemVersionReport describes version information about the running Podman service
 type SystemVersionReport struct {
 	// Always populated
@@ -143,3 +218,17 @@ type LocksReport struct {
 	LockConflicts map[uint32][]string
 	LocksHeld     []uint32
 }
+
+// MonitorReport is a single sample emitted by the monitor endpoint.
+type MonitorReport struct {
+	Read       time.Time
+	Host       *define.HostStats
+	Containers []MonitorContainerReport
+}
+
+// MonitorContainerReport describes the resource usage of a single container
+// in a MonitorReport.
+type MonitorContainerReport struct {
+	define.ContainerStats
+	PodID string `json:",omitempty"`
+}
@@ -54,3 +54,29 @@ type VolumeImportOptions struct {
 	// Input will be closed upon being fully consumed
 	Input io.Reader
 }
+
+// VolumeSnapshotOptions describes the options for taking a snapshot of a
+// volume.
+type VolumeSnapshotOptions struct {
+	// Name of the snapshot. If empty, it is generated from the name of the
+	// volume and the current time.
+	Name string
+	// Force taking the snapshot of a volume used by a running container.
+	Force bool
+}
+
+// VolumeCloneOptions describes the options for cloning a volume.
+type VolumeCloneOptions struct {
+	// Name of the clone.
+	Name string
+	// Force cloning a volume used by a running container.
+	Force bool
+}
+
+// VolumeRollbackOptions describes the options for rolling a volume back to
+// one of its snapshots.
+type VolumeRollbackOptions struct {
+	// Snapshot to restore. If empty, the most recent snapshot of the
+	// volume is restored.
+	Snapshot string
+}
@@ -12,10 +12,14 @@ import (
 
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/opencontainers/go-digest"
+	specV1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/sirupsen/logrus"
 	"go.podman.io/common/libimage"
+	"go.podman.io/common/pkg/libartifact"
 	"go.podman.io/common/pkg/libartifact/store"
 	"go.podman.io/common/pkg/libartifact/types"
+	"go.podman.io/image/v5/signature"
+	"go.podman.io/storage/pkg/stringid"
 )
 
 func (ir *ImageEngine) ArtifactInspect(ctx context.Context, name string, _ entities.ArtifactInspectOptions) (*entities.ArtifactInspectReport, error) {
@@ -77,6 +81,10 @@ func (ir *ImageEngine) ArtifactPull(ctx context.Context, name string, opts entit
 	pullOptions.Writer = opts.Writer
 	pullOptions.OciDecryptConfig = opts.OciDecryptConfig
 	pullOptions.MaxRetries = opts.MaxRetries
+	// The signatures are verified against the signature policy before the
+	// artifact is copied, the OCI layout of the artifact store cannot hold
+	// them.
+	pullOptions.RemoveSignatures = true
 	if opts.RetryDelay != "" {
 		duration, err := time.ParseDuration(opts.RetryDelay)
 		if err != nil {
@@ -94,6 +102,14 @@ func (ir *ImageEngine) ArtifactPull(ctx context.Context, name string, opts entit
 	}
 	artifactDigest, err := artStore.Pull(ctx, artRefToPull, *pullOptions)
 	if err != nil {
+		var (
+			policyErr    signature.PolicyRequirementError
+			signatureErr signature.InvalidSignatureError
+		)
+		if errors.As(err, &policyErr) || errors.As(err, &signatureErr) {
+			report := &entities.ArtifactPullReport{Error: err.Error()}
+			return report, fmt.Errorf("artifact %s rejected by the signature policy: %w", name, err)
+		}
 		return nil, err
 	}
 
@@ -230,6 +246,19 @@ func (ir *ImageEngine) ArtifactAdd(ctx context.Context, name string, artifactBlo
 	if err != nil {
 		return nil, err
 	}
+	// If replace file is true and the artifact exists, swap the files with
+	// the same name and keep all others
+	if opts.ReplaceFile {
+		artifactDigest, err := replaceArtifactFiles(ctx, artStore, artToAdd, artifactBlobs, opts)
+		if err == nil {
+			return &entities.ArtifactAddReport{
+				ArtifactDigest: artifactDigest,
+			}, nil
+		}
+		if !errors.Is(err, types.ErrArtifactNotExist) {
+			return nil, err
+		}
+	}
 	// If replace is true, try to remove existing artifact (ignore errors if it doesn't exist)
 	if opts.Replace {
 		if _, err = artStore.Remove(ctx, artToAdd.ToArtifactStoreReference()); err != nil && !errors.Is(err, types.ErrArtifactNotExist) {
@@ -254,6 +283,164 @@ func (ir *ImageEngine) ArtifactAdd(ctx context.Context, name string, artifactBlo
 	}, nil
 }
 
+// replaceArtifactFiles replaces the layers of the existing artifact dest with
+// the same title as the given files and adds the other files at the end.  The
+// new contents are staged as a temporary artifact first, so dest is left
+// unchanged when adding a file fails.  The staged artifact keeps the blobs
+// around while dest is removed and added again with its layers.
+// types.ErrArtifactNotExist is returned if dest does not exist.
+func replaceArtifactFiles(ctx context.Context, artStore *store.ArtifactStore, dest store.ArtifactReference, artifactBlobs []entities.ArtifactBlob, opts entities.ArtifactAddOptions) (*digest.Digest, error) {
+	arty, err := artStore.Inspect(ctx, dest.ToArtifactStoreReference())
+	if err != nil {
+		return nil, err
+	}
+	oldDigest, err := arty.GetDigest()
+	if err != nil {
+		return nil, err
+	}
+	layers, err := artifactLayerBlobs(ctx, artStore, dest, arty)
+	if err != nil {
+		return nil, err
+	}
+
+	newLayers := make(map[string]artifactLayerBlob, len(artifactBlobs))
+	titles := make([]string, 0, len(artifactBlobs))
+	for _, blob := range artifactBlobs {
+		layer := artifactLayerBlob{
+			blob: blob,
+			options: types.AddOptions{
+				Annotations:  opts.Annotations,
+				FileMIMEType: opts.FileMIMEType,
+			},
+		}
+		title := blob.FileName
+		if annotated, ok := opts.Annotations[specV1.AnnotationTitle]; ok {
+			title = annotated
+		}
+		if _, ok := newLayers[title]; !ok {
+			titles = append(titles, title)
+		}
+		newLayers[title] = layer
+	}
+	for i := range layers {
+		title := arty.Manifest.Layers[i].Annotations[specV1.AnnotationTitle]
+		if newLayer, ok := newLayers[title]; ok {
+			layers[i] = newLayer
+			delete(newLayers, title)
+		}
+	}
+	for _, title := range titles {
+		if newLayer, ok := newLayers[title]; ok {
+			layers = append(layers, newLayer)
+		}
+	}
+	manifestOptions := types.AddOptions{
+		Annotations:      arty.Manifest.Annotations,
+		ArtifactMIMEType: arty.Manifest.ArtifactType,
+	}
+	if opts.ArtifactMIMEType != "" {
+		manifestOptions.ArtifactMIMEType = opts.ArtifactMIMEType
+	}
+
+	staged, err := store.NewArtifactReference(fmt.Sprintf("%s:replace-%s", dest.RepoName(), stringid.GenerateRandomID()[:12]))
+	if err != nil {
+		return nil, err
+	}
+	keepStaged := false
+	defer func() {
+		if keepStaged {
+			return
+		}
+		if _, err := artStore.Remove(ctx, staged.ToArtifactStoreReference()); err != nil && !errors.Is(err, types.ErrArtifactNotExist) {
+			logrus.Errorf("Removing staged artifact %s: %v", staged.String(), err)
+		}
+	}()
+	if _, err := addArtifactLayers(ctx, artStore, staged, manifestOptions, layers); err != nil {
+		return nil, err
+	}
+	stagedArty, err := artStore.Inspect(ctx, staged.ToArtifactStoreReference())
+	if err != nil {
+		return nil, err
+	}
+	layers, err = artifactLayerBlobs(ctx, artStore, staged, stagedArty)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := artStore.Inspect(ctx, dest.ToArtifactStoreReference())
+	if err != nil {
+		return nil, err
+	}
+	currentDigest, err := current.GetDigest()
+	if err != nil {
+		return nil, err
+	}
+	if *currentDigest != *oldDigest {
+		return nil, fmt.Errorf("artifact %s was changed while replacing its files", dest.String())
+	}
+	if _, err := artStore.Remove(ctx, dest.ToArtifactStoreReference()); err != nil {
+		return nil, err
+	}
+	artifactDigest, err := addArtifactLayers(ctx, artStore, dest, manifestOptions, layers)
+	if err != nil {
+		if _, rmErr := artStore.Remove(ctx, dest.ToArtifactStoreReference()); rmErr != nil && !errors.Is(rmErr, types.ErrArtifactNotExist) {
+			logrus.Errorf("Removing partially replaced artifact %s: %v", dest.String(), rmErr)
+		}
+		keepStaged = true
+		return nil, fmt.Errorf("replacing files of artifact %s, its new contents are kept as %s: %w", dest.String(), staged.String(), err)
+	}
+	return artifactDigest, nil
+}
+
+// artifactLayerBlob is a file of an artifact with the options of its layer.
+type artifactLayerBlob struct {
+	blob    entities.ArtifactBlob
+	options types.AddOptions
+}
+
+// artifactLayerBlobs returns the files of the layers of the artifact ref in
+// the artifact store, with the annotations and media types of the layers.
+func artifactLayerBlobs(ctx context.Context, artStore *store.ArtifactStore, ref store.ArtifactReference, arty *libartifact.Artifact) ([]artifactLayerBlob, error) {
+	mountPaths, err := artStore.BlobMountPaths(ctx, ref.ToArtifactStoreReference(), &types.BlobMountPathOptions{})
+	if err != nil {
+		return nil, err
+	}
+	layers := make([]artifactLayerBlob, 0, len(mountPaths))
+	for i, mountPath := range mountPaths {
+		layer := arty.Manifest.Layers[i]
+		layers = append(layers, artifactLayerBlob{
+			blob: entities.ArtifactBlob{
+				BlobFilePath: mountPath.SourcePath,
+				FileName:     layer.Annotations[specV1.AnnotationTitle],
+			},
+			options: types.AddOptions{
+				Annotations:  layer.Annotations,
+				FileMIMEType: layer.MediaType,
+			},
+		})
+	}
+	return layers, nil
+}
+
+// addArtifactLayers adds the artifact ref with the given layers to the
+// artifact store and returns the digest of its manifest.  Each layer is
+// appended on its own to keep its annotations and media type.
+func addArtifactLayers(ctx context.Context, artStore *store.ArtifactStore, ref store.ArtifactReference, manifestOptions types.AddOptions, layers []artifactLayerBlob) (*digest.Digest, error) {
+	artifactDigest, err := artStore.Add(ctx, ref, nil, &manifestOptions)
+	if err != nil {
+		return nil, err
+	}
+	for _, layer := range layers {
+		options := layer.options
+		options.Append = true
+		artifactDigest, err = artStore.Add(ctx, ref, []entities.ArtifactBlob{layer.blob}, &options)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return artifactDigest, nil
+}
+
 func (ir *ImageEngine) ArtifactExtract(ctx context.Context, name string, target string, opts entities.ArtifactExtractOptions) error {
 	artStore, err := ir.Libpod.ArtifactStore()
 	if err != nil {
@@ -0,0 +1,72 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	specV1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/common/pkg/libartifact/store"
+	"go.podman.io/common/pkg/libartifact/types"
+	"go.podman.io/image/v5/oci/layout"
+)
+
+func testArtifactBlob(name, content string) entities.ArtifactBlob {
+	return entities.ArtifactBlob{BlobReader: strings.NewReader(content), FileName: name}
+}
+
+func TestArtifactAddReplaceFile(t *testing.T) {
+	ctx := context.Background()
+	storePath := t.TempDir()
+	artStore, err := store.NewArtifactStore(storePath, nil)
+	require.NoError(t, err)
+	dest, err := store.NewArtifactReference("localhost/test/artifact:latest")
+	require.NoError(t, err)
+
+	blobs := []entities.ArtifactBlob{testArtifactBlob("a.txt", "one"), testArtifactBlob("b.txt", "two")}
+	_, err = artStore.Add(ctx, dest, blobs, &types.AddOptions{})
+	require.NoError(t, err)
+	before, err := artStore.Inspect(ctx, dest.ToArtifactStoreReference())
+	require.NoError(t, err)
+
+	blobs = []entities.ArtifactBlob{testArtifactBlob("a.txt", "changed"), testArtifactBlob("c.txt", "three")}
+	_, err = replaceArtifactFiles(ctx, artStore, dest, blobs, entities.ArtifactAddOptions{})
+	require.NoError(t, err)
+
+	after, err := artStore.Inspect(ctx, dest.ToArtifactStoreReference())
+	require.NoError(t, err)
+	require.Len(t, after.Manifest.Layers, 3)
+	// the replaced file keeps its position, the other file its blob
+	assert.Equal(t, "a.txt", after.Manifest.Layers[0].Annotations[specV1.AnnotationTitle])
+	assert.Equal(t, int64(len("changed")), after.Manifest.Layers[0].Size)
+	assert.Equal(t, before.Manifest.Layers[1], after.Manifest.Layers[1])
+	assert.Equal(t, "c.txt", after.Manifest.Layers[2].Annotations[specV1.AnnotationTitle])
+	assert.Equal(t, before.Manifest.ArtifactType, after.Manifest.ArtifactType)
+
+	// the previous manifest and the staged artifact are removed
+	lrs, err := layout.List(storePath)
+	require.NoError(t, err)
+	assert.Len(t, lrs, 1)
+
+	// a failed replace leaves the artifact unchanged
+	blobs = []entities.ArtifactBlob{testArtifactBlob("a.txt", "again"), {BlobFilePath: filepath.Join(t.TempDir(), "missing"), FileName: "missing"}}
+	_, err = replaceArtifactFiles(ctx, artStore, dest, blobs, entities.ArtifactAddOptions{})
+	assert.ErrorContains(t, err, "no such file or directory")
+	unchanged, err := artStore.Inspect(ctx, dest.ToArtifactStoreReference())
+	require.NoError(t, err)
+	assert.Equal(t, after.Manifest, unchanged.Manifest)
+	lrs, err = layout.List(storePath)
+	require.NoError(t, err)
+	assert.Len(t, lrs, 1)
+
+	missing, err := store.NewArtifactReference("localhost/test/missing:latest")
+	require.NoError(t, err)
+	_, err = replaceArtifactFiles(ctx, artStore, missing, blobs, entities.ArtifactAddOptions{})
+	assert.ErrorIs(t, err, types.ErrArtifactNotExist)
+}
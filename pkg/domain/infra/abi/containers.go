@@ -10,6 +10,7 @@ import (
 	"maps"
 	"os"
 	"reflect"
+	"regexp"
 	"strconv"
 	"sync"
 	"time"
@@ -733,19 +734,20 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 	)
 
 	restoreOptions := libpod.ContainerCheckpointOptions{
-		Keep:            options.Keep,
-		TCPEstablished:  options.TCPEstablished,
-		TCPClose:        options.TCPClose,
-		TargetFile:      options.Import,
-		Name:            options.Name,
-		IgnoreRootfs:    options.IgnoreRootFS,
-		IgnoreVolumes:   options.IgnoreVolumes,
-		IgnoreStaticIP:  options.IgnoreStaticIP,
-		IgnoreStaticMAC: options.IgnoreStaticMAC,
-		ImportPrevious:  options.ImportPrevious,
-		Pod:             options.Pod,
-		PrintStats:      options.PrintStats,
-		FileLocks:       options.FileLocks,
+		Keep:                options.Keep,
+		TCPEstablished:      options.TCPEstablished,
+		TCPClose:            options.TCPClose,
+		TargetFile:          options.Import,
+		Name:                options.Name,
+		IgnoreRootfs:        options.IgnoreRootFS,
+		IgnoreVolumes:       options.IgnoreVolumes,
+		IgnoreStaticIP:      options.IgnoreStaticIP,
+		IgnoreStaticMAC:     options.IgnoreStaticMAC,
+		ImportPrevious:      options.ImportPrevious,
+		ImportPreviousChain: options.ImportPreviousChain,
+		Pod:                 options.Pod,
+		PrintStats:          options.PrintStats,
+		FileLocks:           options.FileLocks,
 	}
 
 	filterFuncs := []libpod.ContainerFilter{
@@ -1342,6 +1344,14 @@ func (ic *ContainerEngine) ContainerLogs(ctx context.Context, namesOrIds []strin
 		Colors:     options.Colors,
 		UseName:    options.Names,
 		WaitGroup:  &wg,
+		Filters:    options.Filters,
+	}
+	if options.Grep != "" {
+		grep, err := regexp.Compile(options.Grep)
+		if err != nil {
+			return fmt.Errorf("invalid grep pattern %q: %w", options.Grep, err)
+		}
+		logOpts.Grep = grep
 	}
 
 	chSize := len(containers)
@@ -1441,6 +1451,20 @@ func (ic *ContainerEngine) ContainerInit(ctx context.Context, namesOrIds []strin
 	return reports, nil
 }
 
+func (ic *ContainerEngine) ContainerRotateLogs(_ context.Context, namesOrIds []string, options entities.ContainerRotateLogsOptions) ([]*entities.ContainerRotateLogsReport, error) {
+	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds})
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.ContainerRotateLogsReport, 0, len(containers))
+	for _, ctr := range containers {
+		report := entities.ContainerRotateLogsReport{Id: ctr.ID(), RawInput: ctr.rawInput}
+		report.Rotated, report.Err = ctr.RotateLog()
+		reports = append(reports, &report)
+	}
+	return reports, nil
+}
+
 func (ic *ContainerEngine) ContainerMount(_ context.Context, nameOrIDs []string, options entities.ContainerMountOptions) ([]*entities.ContainerMountReport, error) {
 	hasCapSysAdmin, err := unshare.HasCapSysAdmin()
 	if err != nil {
@@ -1795,6 +1819,7 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 	}
 
 	ctrCloneOpts.CreateOpts.HealthOnFailure = spec.HealthCheckOnFailureAction.String()
+	ctrCloneOpts.CreateOpts.HealthOnFailureHook = spec.HealthCheckOnFailureHook
 	ctrCloneOpts.CreateOpts.HealthLogDestination = spec.HealthLogDestination
 	ctrCloneOpts.CreateOpts.HealthMaxLogCount = spec.HealthMaxLogCount
 	ctrCloneOpts.CreateOpts.HealthMaxLogSize = spec.HealthMaxLogSize
@@ -0,0 +1,217 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	domainUtils "github.com/containers/podman/v6/pkg/domain/utils"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/pkg/ssh"
+	"go.podman.io/storage/pkg/archive"
+)
+
+// containerMigration runs the commands needed to migrate a container on the
+// host of a system connection.
+type containerMigration struct {
+	url      *url.URL
+	identity string
+	port     int
+	mode     ssh.EngineMode
+}
+
+func newContainerMigration(connection string, ic *ContainerEngine, mode ssh.EngineMode) (*containerMigration, error) {
+	cfg, err := ic.Libpod.GetConfigNoCopy()
+	if err != nil {
+		return nil, err
+	}
+	sshInfo := entities.ImageScpConnections{}
+	if err := domainUtils.GetServiceInformation(&sshInfo, []string{connection}, cfg); err != nil {
+		return nil, err
+	}
+	m := &containerMigration{
+		url:      sshInfo.URI[0],
+		identity: sshInfo.Identities[0],
+		mode:     mode,
+	}
+	if urlPort := m.url.Port(); urlPort != "" {
+		if m.port, err = strconv.Atoi(urlPort); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// exec runs the command on the remote host and returns its output.
+func (m *containerMigration) exec(args ...string) (string, error) {
+	out, err := ssh.Exec(&ssh.ConnectionExecOptions{Host: m.url.String(), Identity: m.identity, Port: m.port, User: m.url.User, Args: args}, m.mode)
+	return strings.TrimSuffix(out, "\n"), err
+}
+
+// copy copies the local file to the remote host.
+func (m *containerMigration) copy(localFile, remoteFile string) error {
+	_, err := ssh.Scp(&ssh.ConnectionScpOptions{User: m.url.User, Identity: m.identity, Port: m.port, Source: localFile, Destination: "ssh://" + m.url.User.String() + "@" + m.url.Hostname() + ":" + remoteFile}, m.mode)
+	return err
+}
+
+// ContainerMigrate moves a running container to the host of a system
+// connection.  The memory of the container is transferred in chained
+// pre-checkpoints while it keeps running, so only the memory changed since
+// the last of them is part of the final checkpoint.  The local container is
+// removed once it has been restored on the remote host, otherwise it is
+// restored locally again.
+func (ic *ContainerEngine) ContainerMigrate(ctx context.Context, nameOrID, connection string, options entities.ContainerMigrateOptions) (*entities.ContainerMigrateReport, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	m, err := newContainerMigration(connection, ic, options.SSHMode)
+	if err != nil {
+		return nil, err
+	}
+
+	localDir, err := os.MkdirTemp("", "migrate")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(localDir); err != nil {
+			logrus.Errorf("Could not recursively remove %s: %q", localDir, err)
+		}
+	}()
+	remoteDir, err := m.exec("mktemp", "-d")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory on %s: %w", connection, err)
+	}
+	defer func() {
+		if _, err := m.exec("rm", "-rf", remoteDir); err != nil {
+			logrus.Errorf("Removing temporary directory %s on %s: %v", remoteDir, connection, err)
+		}
+	}()
+
+	restoreArgs := []string{"podman", "container", "restore"}
+	for i := range options.PreDumps {
+		preCheckpointOptions := libpod.ContainerCheckpointOptions{
+			PreCheckPoint: true,
+			WithPrevious:  i > 0,
+		}
+		if _, _, err := ctr.Checkpoint(ctx, preCheckpointOptions); err != nil {
+			return nil, fmt.Errorf("pre-checkpointing container %s: %w", ctr.ID(), err)
+		}
+		name := fmt.Sprintf("pre-checkpoint-%d.tar.zst", i)
+		if err := exportPreCheckpointLayer(ctr, filepath.Join(localDir, name), options.PreDumps-1-i); err != nil {
+			return nil, fmt.Errorf("exporting pre-checkpoint of container %s: %w", ctr.ID(), err)
+		}
+		remoteFile := path.Join(remoteDir, name)
+		if err := m.copy(filepath.Join(localDir, name), remoteFile); err != nil {
+			return nil, fmt.Errorf("copying pre-checkpoint to %s: %w", connection, err)
+		}
+		restoreArgs = append(restoreArgs, "--import-previous", remoteFile)
+	}
+
+	checkpointFile := filepath.Join(localDir, "checkpoint.tar.zst")
+	checkpointOptions := libpod.ContainerCheckpointOptions{
+		TargetFile:     checkpointFile,
+		WithPrevious:   options.PreDumps > 0,
+		TCPEstablished: options.TCPEstablished,
+		FileLocks:      options.FileLocks,
+		IgnoreRootfs:   options.IgnoreRootFS,
+		IgnoreVolumes:  options.IgnoreVolumes,
+		Compression:    archive.Zstd,
+	}
+	if _, _, err := ctr.Checkpoint(ctx, checkpointOptions); err != nil {
+		return nil, fmt.Errorf("checkpointing container %s: %w", ctr.ID(), err)
+	}
+
+	// The container is stopped now and must be restored locally again,
+	// unless it is running on the remote host.
+	restoreLocally := true
+	defer func() {
+		if !restoreLocally {
+			return
+		}
+		restoreOptions := libpod.ContainerCheckpointOptions{
+			TCPEstablished: options.TCPEstablished,
+			FileLocks:      options.FileLocks,
+		}
+		if _, _, err := ctr.Restore(ctx, restoreOptions); err != nil {
+			logrus.Errorf("Restoring container %s after failed migration: %v", ctr.ID(), err)
+		}
+	}()
+
+	remoteFile := path.Join(remoteDir, filepath.Base(checkpointFile))
+	if err := m.copy(checkpointFile, remoteFile); err != nil {
+		return nil, fmt.Errorf("copying checkpoint to %s: %w", connection, err)
+	}
+	restoreArgs = append(restoreArgs, "--import", remoteFile)
+	if options.IgnoreRootFS {
+		restoreArgs = append(restoreArgs, "--ignore-rootfs")
+	}
+	if options.IgnoreVolumes {
+		restoreArgs = append(restoreArgs, "--ignore-volumes")
+	}
+	if options.IgnoreStaticIP {
+		restoreArgs = append(restoreArgs, "--ignore-static-ip")
+	}
+	if options.IgnoreStaticMAC {
+		restoreArgs = append(restoreArgs, "--ignore-static-mac")
+	}
+	if options.TCPEstablished {
+		restoreArgs = append(restoreArgs, "--tcp-established")
+	}
+	if options.FileLocks {
+		restoreArgs = append(restoreArgs, "--file-locks")
+	}
+	out, err := m.exec(restoreArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("restoring container %s on %s: %w", ctr.ID(), connection, err)
+	}
+	restoreLocally = false
+	lines := strings.Split(out, "\n")
+	report := &entities.ContainerMigrateReport{
+		Id:       ctr.ID(),
+		RemoteId: lines[len(lines)-1],
+	}
+
+	if err := ic.Libpod.RemoveContainer(ctx, ctr, true, true, nil); err != nil {
+		return report, fmt.Errorf("removing migrated container %s: %w", ctr.ID(), err)
+	}
+	return report, nil
+}
+
+// exportPreCheckpointLayer writes the pre-checkpoint-images of the last
+// pre-dump of the container without the ones of previous pre-dumps to
+// target.  The images are placed depth levels down the chain of
+// pre-checkpoints, where they are found once all pre-dumps are done.
+func exportPreCheckpointLayer(ctr *libpod.Container, target string, depth int) error {
+	preCheckpointPath := ctr.PreCheckPointPath()
+	name := filepath.Base(preCheckpointPath)
+	input, err := archive.TarWithOptions(filepath.Dir(preCheckpointPath), &archive.TarOptions{
+		Compression:     archive.Zstd,
+		IncludeFiles:    []string{name},
+		ExcludePatterns: []string{filepath.Join(name, "parent")},
+		RebaseNames:     map[string]string{name: filepath.Join(name, strings.Repeat("parent/", depth))},
+	})
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	output, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+	_, err = io.Copy(output, input)
+	return err
+}
@@ -117,8 +117,8 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string,
 		content     [][]byte
 	)
 
-	if options.Replicas > 1 && options.Type != define.K8sKindDeployment {
-		return nil, fmt.Errorf("--replicas can only be set when --type is set to deployment")
+	if options.Replicas > 1 && options.Type != define.K8sKindDeployment && options.Type != define.K8sKindStatefulSet {
+		return nil, fmt.Errorf("--replicas can only be set when --type is set to deployment or statefulset")
 	}
 	if options.Replicas < 1 {
 		return nil, fmt.Errorf("--replicas has to be greater than or equal to 1. By default, --replicas is set to 1")
@@ -249,6 +249,16 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string,
 				return nil, err
 			}
 			typeContent = append(typeContent, b)
+		case define.K8sKindStatefulSet:
+			sts, err := libpod.GenerateForKubeStatefulSet(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+			if err != nil {
+				return nil, err
+			}
+			b, err := generateKubeYAML(sts)
+			if err != nil {
+				return nil, err
+			}
+			typeContent = append(typeContent, b)
 		case define.K8sKindJob:
 			job, err := libpod.GenerateForKubeJob(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
 			if err != nil {
@@ -266,7 +276,7 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string,
 			}
 			typeContent = append(typeContent, b)
 		default:
-			return nil, fmt.Errorf("invalid generation type - only pods, deployments, jobs, and daemonsets are currently supported: %+v", options.Type)
+			return nil, fmt.Errorf("invalid generation type - only pods, deployments, statefulsets, jobs, and daemonsets are currently supported: %+v", options.Type)
 		}
 
 		if options.Service {
@@ -326,6 +336,16 @@ func getKubePods(ctx context.Context, pods []*libpod.Pod, options entities.Gener
 				return nil, nil, err
 			}
 			out = append(out, b)
+		case define.K8sKindStatefulSet:
+			sts, err := libpod.GenerateForKubeStatefulSet(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+			if err != nil {
+				return nil, nil, err
+			}
+			b, err := generateKubeYAML(sts)
+			if err != nil {
+				return nil, nil, err
+			}
+			out = append(out, b)
 		case define.K8sKindJob:
 			job, err := libpod.GenerateForKubeJob(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
 			if err != nil {
@@ -343,7 +363,7 @@ func getKubePods(ctx context.Context, pods []*libpod.Pod, options entities.Gener
 			}
 			out = append(out, b)
 		default:
-			return nil, nil, fmt.Errorf("invalid generation type - only pods, deployments, jobs, and daemonsets are currently supported")
+			return nil, nil, fmt.Errorf("invalid generation type - only pods, deployments, statefulsets, jobs, and daemonsets are currently supported")
 		}
 
 		if options.Service {
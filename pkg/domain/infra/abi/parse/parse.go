@@ -99,6 +99,9 @@ func VolumeOptions(opts map[string]string) ([]libpod.VolumeCreateOption, error)
 			if len(finalVal) > 0 {
 				volumeOptions[key] = strings.Join(finalVal, ",")
 			}
+		case "quota-backend":
+			logrus.Debugf("Removing quota-backend from options and adding WithVolumeQuotaBackend for backend %s", value)
+			libpodOptions = append(libpodOptions, libpod.WithVolumeQuotaBackend(value))
 		default:
 			volumeOptions[key] = value
 		}
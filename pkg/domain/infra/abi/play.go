@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	buildahDefine "github.com/containers/buildah/define"
 	bparse "github.com/containers/buildah/pkg/parse"
@@ -26,6 +27,7 @@ import (
 	"github.com/containers/podman/v6/pkg/domain/infra/abi/internal/expansion"
 	v1apps "github.com/containers/podman/v6/pkg/k8s.io/api/apps/v1"
 	v1 "github.com/containers/podman/v6/pkg/k8s.io/api/core/v1"
+	"github.com/containers/podman/v6/pkg/k8s.io/apimachinery/pkg/api/resource"
 	metav1 "github.com/containers/podman/v6/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
 	"github.com/containers/podman/v6/pkg/specgen"
 	"github.com/containers/podman/v6/pkg/specgen/generate"
@@ -219,6 +221,78 @@ func prepareVolumesFrom(forContainer, podName string, ctrNames, annotations map[
 	return volumesFrom, nil
 }
 
+// prepareNetworkQoS returns the per-network QoS options set by the bandwidth,
+// latency and loss annotations of a pod.
+func prepareNetworkQoS(annotations map[string]string) (map[string]string, error) {
+	qos := make(map[string]string)
+	for annotation, option := range map[string]string{
+		define.KubeIngressBandwidthAnnotation: define.NetworkQoSIngressRate,
+		define.KubeEgressBandwidthAnnotation:  define.NetworkQoSEgressRate,
+	} {
+		value, ok := annotations[annotation]
+		if !ok {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil || quantity.Value() <= 0 {
+			return nil, fmt.Errorf("invalid annotation %s value %q, must be a positive quantity of bits per second", annotation, value)
+		}
+		qos[option] = strconv.FormatInt(quantity.Value(), 10) + "bit"
+	}
+	if value, ok := annotations[define.NetworkLatencyAnnotation]; ok {
+		qos[define.NetworkQoSLatency] = value
+	}
+	if value, ok := annotations[define.NetworkLossAnnotation]; ok {
+		qos[define.NetworkQoSLoss] = value
+	}
+	if _, err := define.ParseNetworkQoS(qos); err != nil {
+		return nil, fmt.Errorf("invalid network QoS annotation: %w", err)
+	}
+	return qos, nil
+}
+
+// prepareRequiresHealthy returns the containers which must be healthy before
+// forContainer is started along with the timeout to wait for them, as set by
+// the requires-healthy annotations.
+func prepareRequiresHealthy(forContainer, podName string, noPodPrefix bool, ctrNames, annotations map[string]string) ([]string, time.Duration, error) {
+	annotationRequires := define.RequiresHealthyAnnotation + "/" + forContainer
+
+	requires, ok := annotations[annotationRequires]
+	if !ok || requires == "" {
+		return nil, 0, nil
+	}
+
+	var timeout time.Duration
+	annotationTimeout := define.RequiresHealthyTimeoutAnnotation + "/" + forContainer
+	if t, ok := annotations[annotationTimeout]; ok {
+		var err error
+		timeout, err = time.ParseDuration(t)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid annotation %s value: %w", annotationTimeout, err)
+		}
+	}
+
+	deps := strings.Split(requires, ",")
+	for idx, dep := range deps {
+		dep = strings.TrimSpace(dep)
+		if dep == "" {
+			return nil, 0, fmt.Errorf("container name cannot be empty in annotation %s", annotationRequires)
+		}
+		if dep == forContainer {
+			return nil, 0, fmt.Errorf("container cannot require itself in annotation %s", annotationRequires)
+		}
+		// Containers of the pod must be defined before the dependent
+		// container in the kube yaml, as they are created in order.
+		// Anything else is treated as an external container.
+		if _, ok := ctrNames[dep]; ok && !noPodPrefix {
+			dep = podName + "-" + dep
+		}
+		deps[idx] = dep
+	}
+
+	return deps, timeout, nil
+}
+
 // Creates the name for a k8s entity based on the provided content of a
 // K8s yaml file and a given suffix.
 func k8sName(content []byte, suffix string) string {
@@ -327,7 +401,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 		}
 
 		// TODO: create constants for the various "kinds" of yaml files.
-		if options.ServiceContainer && serviceContainer == nil && (kind == "Pod" || kind == "Deployment") {
+		if options.ServiceContainer && serviceContainer == nil && (kind == "Pod" || kind == "Deployment" || kind == "StatefulSet") {
 			ctr, err := ic.createServiceContainer(ctx, k8sName(content, "service"), options)
 			if err != nil {
 				return nil, err
@@ -413,6 +487,23 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			report.Pods = append(report.Pods, r.Pods...)
 			validKinds++
 			setRanContainers(r)
+		case "StatefulSet":
+			var statefulSetYAML v1apps.StatefulSet
+
+			if err := yaml.Unmarshal(document, &statefulSetYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube StatefulSet: %w", err)
+			}
+
+			r, proxies, err := ic.playKubeStatefulSet(ctx, &statefulSetYAML, options, &ipIndex, configMaps, serviceContainer)
+			if err != nil {
+				return nil, err
+			}
+			notifyProxies = append(notifyProxies, proxies...)
+
+			report.Pods = append(report.Pods, r.Pods...)
+			report.Volumes = append(report.Volumes, r.Volumes...)
+			validKinds++
+			setRanContainers(r)
 		case "Job":
 			var jobYAML v1.Job
 
@@ -429,6 +520,20 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			report.Pods = append(report.Pods, r.Pods...)
 			validKinds++
 			setRanContainers(r)
+		case "CronJob":
+			var cronJobYAML v1.CronJob
+
+			if err := yaml.Unmarshal(document, &cronJobYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube CronJob: %w", err)
+			}
+
+			r, err := ic.playKubeCronJob(ctx, &cronJobYAML, options, configMaps)
+			if err != nil {
+				return nil, err
+			}
+
+			report.CronJobs = append(report.CronJobs, *r)
+			validKinds++
 		case "PersistentVolumeClaim":
 			var pvcYAML v1.PersistentVolumeClaim
 
@@ -594,6 +699,142 @@ func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAM
 	return &report, proxies, nil
 }
 
+// statefulSetReplicaNames returns the stable pod names of the replicas of a
+// StatefulSet, i.e. name-0, name-1, etc.
+func statefulSetReplicaNames(statefulSetYAML *v1apps.StatefulSet) []string {
+	var numReplicas int32 = 1
+	if statefulSetYAML.Spec.Replicas != nil {
+		numReplicas = *statefulSetYAML.Spec.Replicas
+	}
+	names := make([]string, 0, numReplicas)
+	for i := range numReplicas {
+		names = append(names, fmt.Sprintf("%s-%d", statefulSetYAML.ObjectMeta.Name, i))
+	}
+	return names
+}
+
+// statefulSetClaimName returns the name of the volume backing the given
+// volumeClaimTemplate for a replica.  It follows the Kubernetes naming of
+// <claim>-<statefulset>-<ordinal>.
+func statefulSetClaimName(claimName, replicaName string) string {
+	return claimName + "-" + replicaName
+}
+
+// statefulSetReplicaSpec returns the pod template for a single replica of a
+// StatefulSet with a stable hostname and the volumeClaimTemplates mapped to
+// the replica's volumes.
+func statefulSetReplicaSpec(statefulSetYAML *v1apps.StatefulSet, replicaName string) v1.PodTemplateSpec {
+	podSpec := statefulSetYAML.Spec.Template
+
+	labels := make(map[string]string, len(podSpec.Labels)+1)
+	maps.Copy(labels, podSpec.Labels)
+	labels[v1apps.StatefulSetPodNameLabel] = replicaName
+	podSpec.Labels = labels
+
+	podSpec.Spec.Hostname = replicaName
+
+	volumes := make([]v1.Volume, 0, len(podSpec.Spec.Volumes)+len(statefulSetYAML.Spec.VolumeClaimTemplates))
+	volumes = append(volumes, podSpec.Spec.Volumes...)
+	for _, claim := range statefulSetYAML.Spec.VolumeClaimTemplates {
+		volumes = append(volumes, v1.Volume{
+			Name: claim.Name,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: statefulSetClaimName(claim.Name, replicaName),
+				},
+			},
+		})
+	}
+	podSpec.Spec.Volumes = volumes
+
+	return podSpec
+}
+
+func (ic *ContainerEngine) playKubeStatefulSet(ctx context.Context, statefulSetYAML *v1apps.StatefulSet, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+	var (
+		report  entities.PlayKubeReport
+		proxies []*notifyproxy.NotifyProxy
+	)
+
+	statefulSetName := statefulSetYAML.ObjectMeta.Name
+	if statefulSetName == "" {
+		return nil, nil, errors.New("statefulSet does not have a name")
+	}
+
+	// Replicas are brought up one after the other.  Unless the pods may
+	// be managed in parallel, a replica has to be ready before the next
+	// one is started.
+	waitReady := statefulSetYAML.Spec.PodManagementPolicy != v1apps.ParallelPodManagement && options.Start != types.OptionalBoolFalse
+
+	for _, podName := range statefulSetReplicaNames(statefulSetYAML) {
+		for _, claim := range statefulSetYAML.Spec.VolumeClaimTemplates {
+			claim.Name = statefulSetClaimName(claim.Name, podName)
+			r, err := ic.playKubePVC(ctx, "", &claim)
+			if err != nil {
+				return nil, nil, fmt.Errorf("creating volume for pod %s: %w", podName, err)
+			}
+			report.Volumes = append(report.Volumes, r.Volumes...)
+		}
+
+		podSpec := statefulSetReplicaSpec(statefulSetYAML, podName)
+		podReport, podProxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, statefulSetYAML.Annotations, configMaps, serviceContainer)
+		if err != nil {
+			return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
+		}
+		report.Pods = append(report.Pods, podReport.Pods...)
+		proxies = append(proxies, podProxies...)
+
+		if waitReady {
+			if err := ic.waitKubePodReady(ctx, podReport, options.ReadyTimeout); err != nil {
+				return nil, nil, fmt.Errorf("waiting for pod %s to become ready: %w", podName, err)
+			}
+		}
+	}
+
+	return &report, proxies, nil
+}
+
+// waitKubePodReady waits until all containers of the played pods with a
+// healthcheck are healthy.  Containers without a healthcheck are considered
+// ready once they are running.  An error is returned if the pods do not become
+// ready within the timeout.
+func (ic *ContainerEngine) waitKubePodReady(ctx context.Context, podReport *entities.PlayKubeReport, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = entities.DefaultPlayKubeReadyTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, pod := range podReport.Pods {
+		if len(pod.ContainerErrors) > 0 {
+			return errors.New(strings.Join(pod.ContainerErrors, "; "))
+		}
+		for _, id := range pod.Containers {
+			ctr, err := ic.Libpod.LookupContainer(id)
+			if err != nil {
+				return err
+			}
+			if !ctr.HasHealthCheck() {
+				continue
+			}
+			if _, err := ctr.WaitForConditionWithInterval(ctx, time.Second, define.HealthCheckHealthy, define.HealthCheckUnhealthy); err != nil {
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return fmt.Errorf("container %s did not become healthy within %s", ctr.Name(), timeout)
+				}
+				return err
+			}
+			status, err := ctr.HealthCheckStatus()
+			if err != nil {
+				return err
+			}
+			if status != define.HealthCheckHealthy {
+				return fmt.Errorf("container %s is %s", ctr.Name(), status)
+			}
+		}
+	}
+	return nil
+}
+
 func (ic *ContainerEngine) playKubeJob(ctx context.Context, jobYAML *v1.Job, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	var (
 		jobName string
@@ -732,6 +973,23 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 	}
 	*ipIndex++
 
+	networkQoS, err := prepareNetworkQoS(annotations)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(networkQoS) > 0 {
+		if !podOpt.Net.Network.IsBridge() {
+			return nil, nil, fmt.Errorf("network QoS annotations can only be set when the network mode is bridge: %w", define.ErrInvalidArg)
+		}
+		for name, netOpts := range podOpt.Net.Networks {
+			if netOpts.Options == nil {
+				netOpts.Options = make(map[string]string, len(networkQoS))
+			}
+			maps.Copy(netOpts.Options, networkQoS)
+			podOpt.Net.Networks[name] = netOpts
+		}
+	}
+
 	if len(options.PublishPorts) > 0 {
 		publishPorts, err := specgenutil.CreatePortBindings(options.PublishPorts)
 		if err != nil {
@@ -1065,6 +1323,11 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			volumesFrom = list
 		}
 
+		healthyDeps, healthyDepsTimeout, err := prepareRequiresHealthy(container.Name, podName, options.NoPodPrefix, ctrNames, annotations)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		specgenOpts := kube.CtrSpecGenOptions{
 			Annotations:        annotations,
 			ConfigMaps:         configMaps,
@@ -1096,6 +1359,11 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 			specgenOpts.TerminationGracePeriodSeconds = podYAML.Spec.TerminationGracePeriodSeconds
 		}
 
+		if healthyDeps != nil {
+			specgenOpts.HealthyDependencies = healthyDeps
+			specgenOpts.HealthyDependencyTimeout = healthyDepsTimeout
+		}
+
 		specGen, err := kube.ToSpecGen(ctx, &specgenOpts)
 		if err != nil {
 			return nil, nil, err
@@ -1619,7 +1887,7 @@ func sortKubeKinds(documentList [][]byte) ([][]byte, error) {
 		}
 
 		switch kind {
-		case "Pod", "Deployment", "DaemonSet", "Job":
+		case "Pod", "Deployment", "DaemonSet", "StatefulSet", "Job", "CronJob":
 			sortedDocumentList = append(sortedDocumentList, document)
 		default:
 			sortedDocumentList = append([][]byte{document}, sortedDocumentList...)
@@ -1750,6 +2018,18 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 			}
 			podName := fmt.Sprintf("%s-pod", deploymentName)
 			podNames = append(podNames, podName)
+		case "StatefulSet":
+			var statefulSetYAML v1apps.StatefulSet
+
+			if err := yaml.Unmarshal(document, &statefulSetYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube StatefulSet: %w", err)
+			}
+			for _, podName := range statefulSetReplicaNames(&statefulSetYAML) {
+				podNames = append(podNames, podName)
+				for _, claim := range statefulSetYAML.Spec.VolumeClaimTemplates {
+					volumeNames = append(volumeNames, statefulSetClaimName(claim.Name, podName))
+				}
+			}
 		case "Job":
 			var jobYAML v1.Job
 
@@ -1759,6 +2039,17 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 			jobName := jobYAML.ObjectMeta.Name
 			podName := fmt.Sprintf("%s-pod", jobName)
 			podNames = append(podNames, podName)
+		case "CronJob":
+			var cronJobYAML v1.CronJob
+
+			if err := yaml.Unmarshal(document, &cronJobYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube CronJob: %w", err)
+			}
+			runPodNames, err := ic.downKubeCronJob(ctx, cronJobYAML.Name)
+			if err != nil {
+				return nil, err
+			}
+			podNames = append(podNames, runPodNames...)
 		case "PersistentVolumeClaim":
 			var pvcYAML v1.PersistentVolumeClaim
 			if err := yaml.Unmarshal(document, &pvcYAML); err != nil {
@@ -0,0 +1,657 @@
+//go:build !remote
+
+package abi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	v1 "github.com/containers/podman/v6/pkg/k8s.io/api/core/v1"
+	metav1 "github.com/containers/podman/v6/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/specgen/generate/kube"
+	"github.com/containers/podman/v6/pkg/specgenutil"
+	"github.com/containers/podman/v6/pkg/systemd"
+	"github.com/sirupsen/logrus"
+	systemdCommon "go.podman.io/common/pkg/systemd"
+	"go.podman.io/image/v5/types"
+	"go.podman.io/storage/pkg/stringid"
+	"sigs.k8s.io/yaml"
+)
+
+// kubeCronJobLabel is set on the pods of each run of a CronJob and holds the
+// name of the CronJob.
+const kubeCronJobLabel = "io.podman.kube.cronjob"
+
+const (
+	// Default history limits of a CronJob as in Kubernetes.
+	defaultSuccessfulJobsHistoryLimit = 3
+	defaultFailedJobsHistoryLimit     = 1
+
+	cronJobSchedulerSystemd  = "systemd"
+	cronJobSchedulerInternal = "internal"
+)
+
+// cronJobUnitName returns the name of the transient systemd timer and service
+// running the CronJob.
+func cronJobUnitName(name string) string {
+	return "podman-cronjob-" + name
+}
+
+// cronJobFiles are the files of a CronJob played by kube play.
+type cronJobFiles struct {
+	// store holds the CronJob and the ConfigMaps of its YAML.
+	store string
+	// options holds the options of kube play used for each run.
+	options string
+	// pidFile holds the PID of the internal scheduler.
+	pidFile string
+	// logFile receives the output of the internal scheduler.
+	logFile string
+}
+
+// cronJobPaths returns the paths of the files of the CronJob.
+func (ic *ContainerEngine) cronJobPaths(name string) (*cronJobFiles, error) {
+	if !define.NameRegex.MatchString(name) {
+		return nil, fmt.Errorf("cronjob %q: %w", name, define.RegexError)
+	}
+	cfg, err := ic.Libpod.GetConfigNoCopy()
+	if err != nil {
+		return nil, err
+	}
+	staticDir := filepath.Join(cfg.Engine.StaticDir, "kube-cronjobs")
+	tmpDir := filepath.Join(cfg.Engine.TmpDir, "kube-cronjobs")
+	return &cronJobFiles{
+		store:   filepath.Join(staticDir, name+".yaml"),
+		options: filepath.Join(staticDir, name+".json"),
+		pidFile: filepath.Join(tmpDir, name+".pid"),
+		logFile: filepath.Join(tmpDir, name+".log"),
+	}, nil
+}
+
+// cronJobPlayOptions returns the options of kube play to store with a CronJob
+// for its runs.  Relative paths are made absolute as runs do not share the
+// working directory of kube play.  Credentials are not stored, runs
+// authenticate with the auth file.  Static IP and MAC addresses are not stored
+// either since concurrent runs cannot share them.
+func cronJobPlayOptions(options entities.PlayKubeOptions) (entities.PlayKubeOptions, error) {
+	runOptions := entities.PlayKubeOptions{
+		Annotations:        options.Annotations,
+		Build:              options.Build,
+		LogDriver:          options.LogDriver,
+		LogOptions:         options.LogOptions,
+		Networks:           options.Networks,
+		NoHostname:         options.NoHostname,
+		NoHosts:            options.NoHosts,
+		NoPodPrefix:        options.NoPodPrefix,
+		PublishAllPorts:    options.PublishAllPorts,
+		PublishPorts:       options.PublishPorts,
+		Quiet:              options.Quiet,
+		SkipTLSVerify:      options.SkipTLSVerify,
+		UseLongAnnotations: options.UseLongAnnotations,
+		Userns:             options.Userns,
+	}
+	var err error
+	if runOptions.CertDir, err = absPathOrEmpty(options.CertDir); err != nil {
+		return runOptions, err
+	}
+	if runOptions.SeccompProfileRoot, err = absPathOrEmpty(options.SeccompProfileRoot); err != nil {
+		return runOptions, err
+	}
+	if runOptions.SignaturePolicy, err = absPathOrEmpty(options.SignaturePolicy); err != nil {
+		return runOptions, err
+	}
+	// The auth file and the context directory of remote clients are
+	// removed once kube play is done.
+	if !options.IsRemote {
+		if runOptions.Authfile, err = absPathOrEmpty(options.Authfile); err != nil {
+			return runOptions, err
+		}
+		if runOptions.ContextDir, err = absPathOrEmpty(options.ContextDir); err != nil {
+			return runOptions, err
+		}
+	}
+	for _, cm := range options.ConfigMaps {
+		abs, err := filepath.Abs(cm)
+		if err != nil {
+			return runOptions, err
+		}
+		runOptions.ConfigMaps = append(runOptions.ConfigMaps, abs)
+	}
+	return runOptions, nil
+}
+
+// absPathOrEmpty returns the absolute path of path unless it is empty.
+func absPathOrEmpty(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	return filepath.Abs(path)
+}
+
+// readCronJobPlayOptions returns the options of kube play stored with the
+// CronJob.  CronJobs stored without options run with the defaults.
+func readCronJobPlayOptions(path string) (entities.PlayKubeOptions, error) {
+	var options entities.PlayKubeOptions
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return options, nil
+		}
+		return options, err
+	}
+	if err := json.Unmarshal(data, &options); err != nil {
+		return options, fmt.Errorf("parsing options of cronjob: %w", err)
+	}
+	if options.Build == types.OptionalBoolTrue {
+		options.SystemContext = &types.SystemContext{
+			AuthFilePath:                options.Authfile,
+			DockerCertPath:              options.CertDir,
+			DockerInsecureSkipTLSVerify: options.SkipTLSVerify,
+			SignaturePolicyPath:         options.SignaturePolicy,
+		}
+	}
+	return options, nil
+}
+
+// playKubeCronJob stores the CronJob along with the ConfigMaps of the YAML and
+// the options of kube play and schedules its runs, preferably with a systemd
+// timer.  Each run creates a new Job from the job template of the CronJob.
+func (ic *ContainerEngine) playKubeCronJob(ctx context.Context, cronJob *v1.CronJob, options entities.PlayKubeOptions, configMaps []v1.ConfigMap) (*entities.PlayKubeCronJob, error) {
+	name := cronJob.Name
+	if name == "" {
+		return nil, errors.New("cronjob does not have a name")
+	}
+	schedule, err := kube.ParseCronSchedule(cronJob.Spec.Schedule, cronJob.Spec.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("cronjob %s: %w", name, err)
+	}
+	policy := cronJob.Spec.ConcurrencyPolicy
+	switch policy {
+	case "":
+		policy = v1.AllowConcurrent
+	case v1.AllowConcurrent, v1.ForbidConcurrent, v1.ReplaceConcurrent:
+	default:
+		return nil, fmt.Errorf("cronjob %s: invalid concurrency policy %q", name, policy)
+	}
+	successfulLimit, failedLimit := int32(defaultSuccessfulJobsHistoryLimit), int32(defaultFailedJobsHistoryLimit)
+	if cronJob.Spec.SuccessfulJobsHistoryLimit != nil {
+		successfulLimit = *cronJob.Spec.SuccessfulJobsHistoryLimit
+	}
+	if cronJob.Spec.FailedJobsHistoryLimit != nil {
+		failedLimit = *cronJob.Spec.FailedJobsHistoryLimit
+	}
+	if successfulLimit < 0 || failedLimit < 0 {
+		return nil, fmt.Errorf("cronjob %s: history limits must not be negative", name)
+	}
+
+	files, err := ic.cronJobPaths(name)
+	if err != nil {
+		return nil, err
+	}
+	// Replace a previously played CronJob of the same name.
+	if err := ic.unscheduleCronJob(ctx, name); err != nil {
+		return nil, err
+	}
+	content, err := marshalCronJob(cronJob, configMaps)
+	if err != nil {
+		return nil, err
+	}
+	runOptions, err := cronJobPlayOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	optionsContent, err := json.Marshal(runOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(files.store), 0o700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(files.options, optionsContent, 0o600); err != nil {
+		return nil, fmt.Errorf("storing options of cronjob %s: %w", name, err)
+	}
+	if err := os.WriteFile(files.store, content, 0o600); err != nil {
+		return nil, fmt.Errorf("storing cronjob %s: %w", name, err)
+	}
+
+	report := &entities.PlayKubeCronJob{Name: name, Schedule: schedule.OnCalendar()}
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+		report.Suspended = true
+		return report, nil
+	}
+
+	cfg, err := ic.Libpod.GetConfigNoCopy()
+	if err != nil {
+		return nil, err
+	}
+	command, err := specgenutil.CreatePodmanArgs(ic.Libpod.StorageConfig(), cfg, false)
+	if err != nil {
+		return nil, err
+	}
+	command = append(command, "kube", "run-cronjob",
+		"--concurrency-policy", string(policy),
+		"--successful-jobs-history-limit", strconv.Itoa(int(successfulLimit)),
+		"--failed-jobs-history-limit", strconv.Itoa(int(failedLimit)))
+
+	err = scheduleCronJobSystemd(name, report.Schedule, append(slices.Clone(command), name))
+	if err == nil {
+		report.Scheduler = cronJobSchedulerSystemd
+		return report, nil
+	}
+	logrus.Debugf("Scheduling cronjob %s with systemd: %v, falling back to the internal scheduler", name, err)
+
+	command = append(command, "--schedule", cronJob.Spec.Schedule)
+	if cronJob.Spec.TimeZone != nil {
+		command = append(command, "--time-zone", *cronJob.Spec.TimeZone)
+	}
+	if err := ic.scheduleCronJobInternal(name, append(command, name)); err != nil {
+		return nil, fmt.Errorf("scheduling cronjob %s: %w", name, err)
+	}
+	report.Scheduler = cronJobSchedulerInternal
+	return report, nil
+}
+
+// marshalCronJob returns the CronJob followed by the ConfigMaps as multi-doc
+// YAML.
+func marshalCronJob(cronJob *v1.CronJob, configMaps []v1.ConfigMap) ([]byte, error) {
+	docs := make([][]byte, 0, len(configMaps)+1)
+	data, err := yaml.Marshal(cronJob)
+	if err != nil {
+		return nil, err
+	}
+	docs = append(docs, data)
+	for _, cm := range configMaps {
+		cm.Kind = "ConfigMap"
+		cm.APIVersion = "v1"
+		data, err := yaml.Marshal(cm)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, data)
+	}
+	return bytes.Join(docs, []byte("---\n")), nil
+}
+
+// scheduleCronJobSystemd runs the command with a transient systemd timer
+// firing on the calendar events.
+func scheduleCronJobSystemd(name string, onCalendar []string, command []string) error {
+	if !systemdCommon.RunsOnSystemd() {
+		return errors.New("not running on systemd")
+	}
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection: %w", err)
+	}
+	conn.Close()
+
+	var args []string
+	if rootless.IsRootless() {
+		args = append(args, "--user")
+	}
+	if path := os.Getenv("PATH"); path != "" {
+		args = append(args, "--setenv=PATH="+path)
+	}
+	args = append(args, "--unit", cronJobUnitName(name), "--timer-property=AccuracySec=1s", "--property=StartLimitIntervalSec=0")
+	for _, event := range onCalendar {
+		args = append(args, "--on-calendar", event)
+	}
+	args = append(args, command...)
+
+	logrus.Debugf("Creating systemd timer for cronjob %s: systemd-run %s", name, args)
+	if output, err := exec.Command("systemd-run", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("systemd-run failed: %w: output: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// scheduleCronJobInternal starts a detached Podman process running the
+// CronJob on its schedule.  Its output is appended to the log file of the
+// CronJob.
+func (ic *ContainerEngine) scheduleCronJobInternal(name string, command []string) error {
+	files, err := ic.cronJobPaths(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(files.pidFile), 0o700); err != nil {
+		return err
+	}
+	logFile, err := os.OpenFile(files.logFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening log file of cronjob %s: %w", name, err)
+	}
+	defer logFile.Close()
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	if err := os.WriteFile(files.pidFile, []byte(strconv.Itoa(cmd.Process.Pid)), 0o600); err != nil {
+		return err
+	}
+	return cmd.Process.Release()
+}
+
+// unscheduleCronJob stops the systemd timer or internal scheduler of the
+// CronJob.  Runs of the CronJob are left untouched.
+func (ic *ContainerEngine) unscheduleCronJob(ctx context.Context, name string) error {
+	files, err := ic.cronJobPaths(name)
+	if err != nil {
+		return err
+	}
+
+	if data, err := os.ReadFile(files.pidFile); err == nil {
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil {
+			// Make sure not to kill an unrelated process reusing the PID.
+			cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+			if err == nil && isCronJobScheduler(cmdline, name) {
+				if err := syscall.Kill(pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+					return fmt.Errorf("stopping scheduler of cronjob %s: %w", name, err)
+				}
+			}
+		}
+		if err := os.Remove(files.pidFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	if !systemdCommon.RunsOnSystemd() {
+		return nil
+	}
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		logrus.Debugf("Unable to get systemd connection to remove timer of cronjob %s: %v", name, err)
+		return nil
+	}
+	defer conn.Close()
+
+	unitName := cronJobUnitName(name)
+	for _, unit := range []string{unitName + ".timer", unitName + ".service"} {
+		ch := make(chan string)
+		if _, err := conn.StopUnitContext(ctx, unit, "ignore-dependencies", ch); err != nil {
+			if !strings.HasSuffix(err.Error(), " not loaded.") {
+				return fmt.Errorf("stopping %q: %w", unit, err)
+			}
+			continue
+		}
+		if msg := <-ch; msg != "done" {
+			return fmt.Errorf("stopping %q: expected %q but received %q", unit, "done", msg)
+		}
+	}
+	if err := conn.ResetFailedUnitContext(ctx, unitName+".service"); err != nil {
+		logrus.Debugf("Failed to reset unit %s.service: %v", unitName, err)
+	}
+	return nil
+}
+
+// isCronJobScheduler returns true if the NUL separated command line belongs to
+// the internal scheduler of the CronJob, which runs run-cronjob with the name
+// of the CronJob as the last argument.
+func isCronJobScheduler(cmdline []byte, name string) bool {
+	args := strings.Split(strings.TrimSuffix(string(cmdline), "\x00"), "\x00")
+	return len(args) > 1 && args[len(args)-1] == name && slices.Contains(args, "run-cronjob")
+}
+
+// downKubeCronJob unschedules and forgets the CronJob and returns the names
+// of the pods of its runs.
+func (ic *ContainerEngine) downKubeCronJob(ctx context.Context, name string) ([]string, error) {
+	files, err := ic.cronJobPaths(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := ic.unscheduleCronJob(ctx, name); err != nil {
+		return nil, err
+	}
+	for _, path := range []string{files.store, files.options, files.logFile} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	pods, err := ic.cronJobRunPods(name)
+	if err != nil {
+		return nil, err
+	}
+	podNames := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		podNames = append(podNames, pod.Name())
+	}
+	return podNames, nil
+}
+
+// cronJobRunPods returns the pods of the runs of the CronJob, oldest first.
+func (ic *ContainerEngine) cronJobRunPods(name string) ([]*libpod.Pod, error) {
+	pods, err := ic.Libpod.Pods(func(p *libpod.Pod) bool {
+		return p.Labels()[kubeCronJobLabel] == name
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(pods, func(a, b *libpod.Pod) int {
+		return a.CreatedTime().Compare(b.CreatedTime())
+	})
+	return pods, nil
+}
+
+// KubeCronJobRun runs the CronJob played by kube play once or, if a schedule
+// is set, on every tick of the schedule until the CronJob is removed.
+func (ic *ContainerEngine) KubeCronJobRun(ctx context.Context, name string, opts entities.KubeCronJobRunOptions) error {
+	if opts.Schedule == "" {
+		return ic.runKubeCronJob(ctx, name, opts)
+	}
+
+	var timeZone *string
+	if opts.TimeZone != "" {
+		timeZone = &opts.TimeZone
+	}
+	schedule, err := kube.ParseCronSchedule(opts.Schedule, timeZone)
+	if err != nil {
+		return err
+	}
+	files, err := ic.cronJobPaths(name)
+	if err != nil {
+		return err
+	}
+	for {
+		next := schedule.Next(time.Now())
+		if next.IsZero() {
+			return fmt.Errorf("cron schedule %q of cronjob %s never matches", opts.Schedule, name)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(next)):
+		}
+		// The CronJob has been removed behind our back.
+		if _, err := os.Stat(files.store); errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err := ic.runKubeCronJob(ctx, name, opts); err != nil {
+			logrus.Errorf("Running cronjob %s: %v", name, err)
+		}
+	}
+}
+
+// runKubeCronJob creates a Job from the job template of the stored CronJob
+// with the stored options of kube play according to its concurrency policy
+// and prunes the history of runs.
+func (ic *ContainerEngine) runKubeCronJob(ctx context.Context, name string, opts entities.KubeCronJobRunOptions) error {
+	files, err := ic.cronJobPaths(name)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(files.store)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no cronjob %s has been played", name)
+		}
+		return err
+	}
+	documentList, err := splitMultiDocYAML(content)
+	if err != nil {
+		return err
+	}
+	var cronJob *v1.CronJob
+	var configMapDocs [][]byte
+	for _, document := range documentList {
+		kind, err := getKubeKind(document)
+		if err != nil {
+			return err
+		}
+		switch kind {
+		case "CronJob":
+			cronJob = new(v1.CronJob)
+			if err := yaml.Unmarshal(document, cronJob); err != nil {
+				return fmt.Errorf("unable to read YAML as Kube CronJob: %w", err)
+			}
+		case "ConfigMap":
+			configMapDocs = append(configMapDocs, document)
+		}
+	}
+	if cronJob == nil {
+		return fmt.Errorf("stored cronjob %s is invalid", name)
+	}
+	playOptions, err := readCronJobPlayOptions(files.options)
+	if err != nil {
+		return fmt.Errorf("cronjob %s: %w", name, err)
+	}
+
+	pods, err := ic.cronJobRunPods(name)
+	if err != nil {
+		return err
+	}
+	var active []string
+	for _, pod := range pods {
+		status, err := pod.GetPodStatus()
+		if err != nil {
+			return err
+		}
+		if status == define.PodStateRunning || status == define.PodStateDegraded || status == define.PodStatePaused {
+			active = append(active, pod.Name())
+		}
+	}
+	if len(active) > 0 {
+		switch v1.ConcurrencyPolicy(opts.ConcurrencyPolicy) {
+		case v1.ForbidConcurrent:
+			logrus.Infof("Skipping run of cronjob %s: previous run is still active", name)
+			return nil
+		case v1.ReplaceConcurrent:
+			if _, err := ic.PodStop(ctx, active, entities.PodStopOptions{Ignore: true, Timeout: -1}); err != nil {
+				return err
+			}
+			if _, err := ic.PodRm(ctx, active, entities.PodRmOptions{Ignore: true, Force: true}); err != nil {
+				return err
+			}
+		}
+	}
+
+	template := cronJob.Spec.JobTemplate
+	job := v1.Job{
+		TypeMeta: metav1.TypeMeta{Kind: "Job", APIVersion: "batch/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			// Name the Job after the time of the run like Kubernetes
+			// does.  The random suffix keeps runs started within the
+			// same second, e.g. manual ones, apart.
+			Name:        fmt.Sprintf("%s-%d-%s", name, time.Now().Unix(), stringid.GenerateRandomID()[:5]),
+			Labels:      template.Labels,
+			Annotations: template.Annotations,
+		},
+		Spec: template.Spec,
+	}
+	if job.Spec.Template.Labels == nil {
+		job.Spec.Template.Labels = make(map[string]string)
+	}
+	job.Spec.Template.Labels[kubeCronJobLabel] = name
+
+	data, err := yaml.Marshal(job)
+	if err != nil {
+		return err
+	}
+	configMapDocs = append(configMapDocs, data)
+	if _, err := ic.PlayKube(ctx, bytes.NewReader(bytes.Join(configMapDocs, []byte("---\n"))), playOptions); err != nil {
+		return fmt.Errorf("running job %s: %w", job.Name, err)
+	}
+
+	return ic.pruneKubeCronJobRuns(ctx, name, opts)
+}
+
+// pruneKubeCronJobRuns removes the oldest finished runs of the CronJob beyond
+// the history limits.
+func (ic *ContainerEngine) pruneKubeCronJobRuns(ctx context.Context, name string, opts entities.KubeCronJobRunOptions) error {
+	pods, err := ic.cronJobRunPods(name)
+	if err != nil {
+		return err
+	}
+	var succeeded, failed []string
+	for _, pod := range pods {
+		status, err := pod.GetPodStatus()
+		if err != nil {
+			return err
+		}
+		if status != define.PodStateExited && status != define.PodStateStopped && status != define.PodStateErrored {
+			continue
+		}
+		ok, err := kubeCronJobRunSucceeded(pod)
+		if err != nil {
+			return err
+		}
+		if ok {
+			succeeded = append(succeeded, pod.Name())
+		} else {
+			failed = append(failed, pod.Name())
+		}
+	}
+
+	var remove []string
+	if excess := len(succeeded) - int(opts.SuccessfulJobsHistoryLimit); excess > 0 {
+		remove = append(remove, succeeded[:excess]...)
+	}
+	if excess := len(failed) - int(opts.FailedJobsHistoryLimit); excess > 0 {
+		remove = append(remove, failed[:excess]...)
+	}
+	if len(remove) == 0 {
+		return nil
+	}
+	logrus.Debugf("Removing finished runs of cronjob %s: %v", name, remove)
+	_, err = ic.PodRm(ctx, remove, entities.PodRmOptions{Ignore: true, Force: true})
+	return err
+}
+
+// kubeCronJobRunSucceeded returns true if all containers of the run exited
+// successfully.
+func kubeCronJobRunSucceeded(pod *libpod.Pod) (bool, error) {
+	ctrs, err := pod.AllContainers()
+	if err != nil {
+		return false, err
+	}
+	for _, ctr := range ctrs {
+		if ctr.IsInfra() {
+			continue
+		}
+		exitCode, exited, err := ctr.ExitCode()
+		if err != nil {
+			return false, err
+		}
+		if !exited || exitCode != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,85 @@
+//go:build !remote
+
+package abi
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/image/v5/types"
+)
+
+func TestIsCronJobScheduler(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmdline  string
+		expected bool
+	}{
+		{
+			name:     "scheduler of the cronjob",
+			cmdline:  "podman\x00kube\x00run-cronjob\x00--schedule\x00* * * * *\x00backup\x00",
+			expected: true,
+		},
+		{
+			name:    "scheduler of another cronjob",
+			cmdline: "podman\x00kube\x00run-cronjob\x00--schedule\x00* * * * *\x00backup-2\x00",
+		},
+		{
+			name:    "cronjob name as an option value",
+			cmdline: "podman\x00kube\x00run-cronjob\x00--time-zone\x00backup\x00other\x00",
+		},
+		{
+			name:    "unrelated process",
+			cmdline: "sleep\x00backup\x00",
+		},
+		{
+			name:    "empty command line",
+			cmdline: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isCronJobScheduler([]byte(test.cmdline), "backup"))
+		})
+	}
+}
+
+func TestCronJobPlayOptions(t *testing.T) {
+	options := entities.PlayKubeOptions{
+		Authfile:    "auth.json",
+		Build:       types.OptionalBoolTrue,
+		ConfigMaps:  []string{"cm.yaml"},
+		ContextDir:  "/context",
+		Networks:    []string{"net1"},
+		Password:    "secret",
+		Replace:     true,
+		Userns:      "auto",
+		Username:    "user",
+		NoPodPrefix: true,
+	}
+	runOptions, err := cronJobPlayOptions(options)
+	require.NoError(t, err)
+	authfile, err := filepath.Abs("auth.json")
+	require.NoError(t, err)
+	configMap, err := filepath.Abs("cm.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, entities.PlayKubeOptions{
+		Authfile:    authfile,
+		Build:       types.OptionalBoolTrue,
+		ConfigMaps:  []string{configMap},
+		ContextDir:  "/context",
+		Networks:    []string{"net1"},
+		Userns:      "auto",
+		NoPodPrefix: true,
+	}, runOptions)
+
+	// The auth file and context directory of remote clients are temporary.
+	options.IsRemote = true
+	runOptions, err = cronJobPlayOptions(options)
+	require.NoError(t, err)
+	assert.Empty(t, runOptions.Authfile)
+	assert.Empty(t, runOptions.ContextDir)
+}
@@ -5,7 +5,9 @@ package abi
 import (
 	"bytes"
 	"testing"
+	"time"
 
+	v1apps "github.com/containers/podman/v6/pkg/k8s.io/api/apps/v1"
 	v1 "github.com/containers/podman/v6/pkg/k8s.io/api/core/v1"
 	v12 "github.com/containers/podman/v6/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
 	"github.com/stretchr/testify/assert"
@@ -278,3 +280,190 @@ items:
 		})
 	}
 }
+
+func TestPrepareRequiresHealthy(t *testing.T) {
+	ctrNames := map[string]string{"db": "", "app": ""}
+	tests := []struct {
+		name             string
+		annotations      map[string]string
+		noPodPrefix      bool
+		expectError      bool
+		expectedErrorMsg string
+		expected         []string
+		expectedTimeout  time.Duration
+	}{
+		{
+			"NoAnnotation",
+			map[string]string{},
+			false,
+			false,
+			"",
+			nil,
+			0,
+		},
+		{
+			"PodAndExternalContainers",
+			map[string]string{
+				"io.podman.annotations.requires-healthy/app":         "db, external",
+				"io.podman.annotations.requires-healthy-timeout/app": "90s",
+			},
+			false,
+			false,
+			"",
+			[]string{"mypod-db", "external"},
+			90 * time.Second,
+		},
+		{
+			"NoPodPrefix",
+			map[string]string{
+				"io.podman.annotations.requires-healthy/app": "db",
+			},
+			true,
+			false,
+			"",
+			[]string{"db"},
+			0,
+		},
+		{
+			"Self",
+			map[string]string{
+				"io.podman.annotations.requires-healthy/app": "app",
+			},
+			false,
+			true,
+			"cannot require itself",
+			nil,
+			0,
+		},
+		{
+			"InvalidTimeout",
+			map[string]string{
+				"io.podman.annotations.requires-healthy/app":         "db",
+				"io.podman.annotations.requires-healthy-timeout/app": "soon",
+			},
+			false,
+			true,
+			"invalid annotation",
+			nil,
+			0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps, timeout, err := prepareRequiresHealthy("app", "mypod", test.noPodPrefix, ctrNames, test.annotations)
+			if test.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, deps)
+				assert.Equal(t, test.expectedTimeout, timeout)
+			}
+		})
+	}
+}
+
+func TestStatefulSetReplicaSpec(t *testing.T) {
+	replicas := int32(2)
+	sts := v1apps.StatefulSet{
+		ObjectMeta: v12.ObjectMeta{Name: "db"},
+		Spec: v1apps.StatefulSetSpec{
+			Replicas: &replicas,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: v12.ObjectMeta{Labels: map[string]string{"app": "db"}},
+				Spec: v1.PodSpec{
+					Hostname: "ignored",
+					Volumes: []v1.Volume{
+						{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}},
+					},
+				},
+			},
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{ObjectMeta: v12.ObjectMeta{Name: "data"}},
+			},
+		},
+	}
+
+	names := statefulSetReplicaNames(&sts)
+	assert.Equal(t, []string{"db-0", "db-1"}, names)
+
+	for _, name := range names {
+		spec := statefulSetReplicaSpec(&sts, name)
+		assert.Equal(t, name, spec.Spec.Hostname)
+		assert.Equal(t, name, spec.Labels[v1apps.StatefulSetPodNameLabel])
+		assert.Equal(t, "db", spec.Labels["app"])
+		assert.Len(t, spec.Spec.Volumes, 2)
+		assert.Equal(t, "config", spec.Spec.Volumes[0].Name)
+		assert.Equal(t, "data", spec.Spec.Volumes[1].Name)
+		assert.Equal(t, "data-"+name, spec.Spec.Volumes[1].PersistentVolumeClaim.ClaimName)
+	}
+
+	// The template of the StatefulSet must not be modified.
+	assert.Equal(t, "ignored", sts.Spec.Template.Spec.Hostname)
+	assert.Len(t, sts.Spec.Template.Spec.Volumes, 1)
+	assert.NotContains(t, sts.Spec.Template.Labels, v1apps.StatefulSetPodNameLabel)
+}
+
+func TestPrepareNetworkQoS(t *testing.T) {
+	tests := []struct {
+		name             string
+		annotations      map[string]string
+		expectedErrorMsg string
+		expected         map[string]string
+	}{
+		{
+			"NoAnnotation",
+			map[string]string{},
+			"",
+			map[string]string{},
+		},
+		{
+			"Bandwidth",
+			map[string]string{
+				"kubernetes.io/ingress-bandwidth": "10M",
+				"kubernetes.io/egress-bandwidth":  "512k",
+			},
+			"",
+			map[string]string{"ingress_rate": "10000000bit", "egress_rate": "512000bit"},
+		},
+		{
+			"LatencyAndLoss",
+			map[string]string{
+				"io.podman.annotations.network-latency": "100ms",
+				"io.podman.annotations.network-loss":    "1.5%",
+			},
+			"",
+			map[string]string{"latency": "100ms", "loss": "1.5%"},
+		},
+		{
+			"InvalidBandwidth",
+			map[string]string{
+				"kubernetes.io/egress-bandwidth": "fast",
+			},
+			"must be a positive quantity",
+			nil,
+		},
+		{
+			"InvalidLoss",
+			map[string]string{
+				"io.podman.annotations.network-loss": "200%",
+			},
+			"must be a percentage between 0 and 100",
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			qos, err := prepareNetworkQoS(test.annotations)
+			if test.expectedErrorMsg != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, qos)
+			}
+		})
+	}
+}
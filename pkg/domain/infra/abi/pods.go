@@ -11,6 +11,7 @@ import (
 
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/checkpoint"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	dfilters "github.com/containers/podman/v6/pkg/domain/filters"
 	"github.com/containers/podman/v6/pkg/signal"
@@ -155,6 +156,42 @@ func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, op
 	return reports, nil
 }
 
+// PodUpdate updates the resource limits, restart policy and labels of the given pod
+func (ic *ContainerEngine) PodUpdate(_ context.Context, options *entities.PodUpdateOptions) (string, error) {
+	pod, err := ic.Libpod.LookupPod(options.NameOrID)
+	if err != nil {
+		return "", err
+	}
+	if err := pod.Update(options); err != nil {
+		return "", err
+	}
+	return pod.ID(), nil
+}
+
+// PodCheckpoint checkpoints all running containers of the given pod into a
+// single archive
+func (ic *ContainerEngine) PodCheckpoint(ctx context.Context, nameOrID string, options entities.PodCheckpointOptions) (*entities.PodCheckpointReport, error) {
+	pod, err := ic.Libpod.LookupPod(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := checkpoint.CRExportPodCheckpoint(ctx, pod, options)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.PodCheckpointReport{Id: pod.ID(), Containers: ids}, nil
+}
+
+// PodRestore restores a pod and its containers from an archive written by
+// PodCheckpoint
+func (ic *ContainerEngine) PodRestore(ctx context.Context, options entities.PodRestoreOptions) (*entities.PodRestoreReport, error) {
+	pod, ids, err := checkpoint.CRImportPodCheckpoint(ctx, ic.Libpod, options)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.PodRestoreReport{Id: pod.ID(), Containers: ids}, nil
+}
+
 func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string, options entities.PodunpauseOptions) ([]*entities.PodUnpauseReport, error) {
 	reports := []*entities.PodUnpauseReport{}
 	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
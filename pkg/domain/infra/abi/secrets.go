@@ -8,11 +8,17 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"slices"
 	"strings"
+	"syscall"
 
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/domain/utils"
+	"github.com/containers/podman/v6/pkg/signal"
+	"github.com/sirupsen/logrus"
 	"go.podman.io/common/pkg/secrets"
 )
 
@@ -65,6 +71,119 @@ func (ic *ContainerEngine) SecretCreate(_ context.Context, name string, reader i
 	}, nil
 }
 
+// SecretUpdate replaces the data of the secret and propagates the new data to
+// the containers using the secret.  The secret keeps its name, driver and
+// labels but gets a new ID as the data is stored anew in the driver.
+func (ic *ContainerEngine) SecretUpdate(ctx context.Context, nameOrID string, reader io.Reader, options entities.SecretUpdateOptions) (*entities.SecretUpdateReport, error) {
+	if options.Restart && options.Signal != "" {
+		return nil, errors.New("cannot restart and signal containers at the same time")
+	}
+	var sig syscall.Signal
+	if options.Signal != "" {
+		var err error
+		sig, err = signal.ParseSignalNameOrNumber(options.Signal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading secret data: %w", err)
+	}
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := manager.Lookup(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	storeOpts := secrets.StoreOptions{
+		DriverOpts: secret.DriverOptions,
+		Metadata:   secret.Metadata,
+		Labels:     secret.Labels,
+		Replace:    true,
+	}
+	secretID, err := manager.Store(secret.Name, data, secret.Driver, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ic.Libpod.ReplaceSecretVersion(secret.ID, secretID); err != nil {
+		logrus.Errorf("Recording the version of secret %s: %v", secret.Name, err)
+	}
+	ic.Libpod.NewSecretEvent(events.Update, secretID)
+
+	secret, err = manager.Lookup(secretID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrs, err := ic.Libpod.GetAllContainers()
+	if err != nil {
+		return nil, err
+	}
+	report := &entities.SecretUpdateReport{ID: secretID}
+	addError := func(ctr *libpod.Container, err error) {
+		if report.Errors == nil {
+			report.Errors = make(map[string]string)
+		}
+		report.Errors[ctr.ID()] = err.Error()
+	}
+	for _, ctr := range ctrs {
+		mounted := slices.ContainsFunc(ctr.Secrets(), func(s *libpod.ContainerSecret) bool {
+			return s.Name == secret.Name
+		})
+		env := false
+		for _, s := range ctr.EnvSecrets() {
+			env = env || s.Name == secret.Name
+		}
+		if !mounted && !env {
+			continue
+		}
+
+		if err := ctr.UpdateSecret(secret); err != nil {
+			addError(ctr, err)
+			continue
+		}
+		report.Containers = append(report.Containers, ctr.ID())
+
+		state, err := ctr.State()
+		if err != nil {
+			addError(ctr, err)
+			continue
+		}
+		if state != define.ContainerStateRunning {
+			continue
+		}
+		switch {
+		case options.Restart:
+			if err := ctr.RestartWithTimeout(ctx, ctr.StopTimeout()); err != nil {
+				addError(ctr, fmt.Errorf("restarting container: %w", err))
+				continue
+			}
+			report.Restarted = append(report.Restarted, ctr.ID())
+		case env:
+			logrus.Warnf("Container %s uses secret %s as environment variable, restart it to apply the update", ctr.ID(), secret.Name)
+		}
+		if mounted && options.Signal != "" {
+			if err := ctr.Kill(uint(sig)); err != nil {
+				addError(ctr, fmt.Errorf("signaling container: %w", err))
+				continue
+			}
+			report.Signaled = append(report.Signaled, ctr.ID())
+		}
+	}
+	return report, nil
+}
+
+// secretVersion returns the version of the secret id in versions, which is 1
+// for secrets never updated.
+func secretVersion(versions map[string]int, id string) int {
+	return max(versions[id], 1)
+}
+
 func (ic *ContainerEngine) SecretInspect(_ context.Context, nameOrIDs []string, options entities.SecretInspectOptions) ([]*entities.SecretInfoReport, []error, error) {
 	var (
 		secret *secrets.Secret
@@ -74,6 +193,10 @@ func (ic *ContainerEngine) SecretInspect(_ context.Context, nameOrIDs []string,
 	if err != nil {
 		return nil, nil, err
 	}
+	versions, err := ic.Libpod.SecretVersions()
+	if err != nil {
+		return nil, nil, err
+	}
 	errs := make([]error, 0, len(nameOrIDs))
 	reports := make([]*entities.SecretInfoReport, 0, len(nameOrIDs))
 	for _, nameOrID := range nameOrIDs {
@@ -96,7 +219,7 @@ func (ic *ContainerEngine) SecretInspect(_ context.Context, nameOrIDs []string,
 		if secret.UpdatedAt.IsZero() {
 			secret.UpdatedAt = secret.CreatedAt
 		}
-		reports = append(reports, secretToReportWithData(*secret, string(data)))
+		reports = append(reports, secretToReportWithData(*secret, string(data), secretVersion(versions, secret.ID)))
 	}
 
 	return reports, errs, nil
@@ -111,6 +234,10 @@ func (ic *ContainerEngine) SecretList(_ context.Context, opts entities.SecretLis
 	if err != nil {
 		return nil, err
 	}
+	versions, err := ic.Libpod.SecretVersions()
+	if err != nil {
+		return nil, err
+	}
 	report := make([]*entities.SecretInfoReport, 0, len(secretList))
 	for _, secret := range secretList {
 		result, err := utils.IfPassesSecretsFilter(secret, opts.Filters)
@@ -118,7 +245,7 @@ func (ic *ContainerEngine) SecretList(_ context.Context, opts entities.SecretLis
 			return nil, err
 		}
 		if result {
-			report = append(report, secretToReport(secret))
+			report = append(report, secretToReport(secret, secretVersion(versions, secret.ID)))
 		}
 	}
 	return report, nil
@@ -151,6 +278,9 @@ func (ic *ContainerEngine) SecretRm(_ context.Context, nameOrIDs []string, optio
 		}
 		reports = append(reports, &entities.SecretRmReport{Err: err, ID: deletedID})
 		if err == nil {
+			if err := ic.Libpod.RemoveSecretVersion(deletedID); err != nil {
+				logrus.Errorf("Removing the version of secret %s: %v", deletedID, err)
+			}
 			ic.Libpod.NewSecretEvent(events.Remove, deletedID)
 		}
 	}
@@ -172,11 +302,11 @@ func (ic *ContainerEngine) SecretExists(_ context.Context, nameOrID string) (*en
 	return &entities.BoolReport{Value: secret != nil}, nil
 }
 
-func secretToReport(secret secrets.Secret) *entities.SecretInfoReport {
-	return secretToReportWithData(secret, "")
+func secretToReport(secret secrets.Secret, version int) *entities.SecretInfoReport {
+	return secretToReportWithData(secret, "", version)
 }
 
-func secretToReportWithData(secret secrets.Secret, data string) *entities.SecretInfoReport {
+func secretToReportWithData(secret secrets.Secret, data string, version int) *entities.SecretInfoReport {
 	return &entities.SecretInfoReport{
 		ID:        secret.ID,
 		CreatedAt: secret.CreatedAt,
@@ -189,6 +319,7 @@ func secretToReportWithData(secret secrets.Secret, data string) *entities.Secret
 			},
 			Labels: secret.Labels,
 		},
+		Version:    entities.SecretVersion{Index: version},
 		SecretData: data,
 	}
 }
@@ -15,6 +15,7 @@ func Test_secretToReport(t *testing.T) {
 	type args struct {
 		secret     secrets.Secret
 		secretData string
+		versions   map[string]int
 	}
 	tests := []struct {
 		name string
@@ -51,13 +52,36 @@ func Test_secretToReport(t *testing.T) {
 					},
 					Labels: map[string]string{"test-label": "test-value"},
 				},
+				Version:    entities.SecretVersion{Index: 1},
 				SecretData: "test-secret-data",
 			},
 		},
+		{
+			name: "test secretToReport with updated secret",
+			args: args{
+				secret: secrets.Secret{
+					Name:     "test-name",
+					ID:       "test-id",
+					Metadata: map[string]string{"version": "5"},
+					Driver:   "test-driver",
+				},
+				versions: map[string]int{"test-id": 3, "other-id": 4},
+			},
+			want: &entities.SecretInfoReport{
+				ID: "test-id",
+				Spec: entities.SecretSpec{
+					Name: "test-name",
+					Driver: entities.SecretDriverSpec{
+						Name: "test-driver",
+					},
+				},
+				Version: entities.SecretVersion{Index: 3},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equalf(t, tt.want, secretToReportWithData(tt.args.secret, tt.args.secretData), "secretToReport(%v)", tt.args.secret)
+			assert.Equalf(t, tt.want, secretToReportWithData(tt.args.secret, tt.args.secretData, secretVersion(tt.args.versions, tt.args.secret.ID)), "secretToReport(%v)", tt.args.secret)
 		})
 	}
 }
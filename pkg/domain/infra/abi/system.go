@@ -6,20 +6,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	"github.com/containers/buildah/pkg/parse"
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/domain/entities/reports"
 	"github.com/containers/podman/v6/pkg/emulation"
 	"github.com/containers/podman/v6/pkg/util"
+	"github.com/opencontainers/go-digest"
+	"go.podman.io/common/libimage"
 	"go.podman.io/storage"
 	"go.podman.io/storage/pkg/directory"
 	"go.podman.io/storage/pkg/fileutils"
+	"go.podman.io/storage/pkg/unshare"
 )
 
 func (ic *ContainerEngine) Info(_ context.Context) (*define.Info, error) {
@@ -178,7 +184,7 @@ func (ic *ContainerEngine) SystemPrune(ctx context.Context, options entities.Sys
 	return systemPruneReport, nil
 }
 
-func (ic *ContainerEngine) SystemDf(ctx context.Context, _ entities.SystemDfOptions) (*entities.SystemDfReport, error) {
+func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.SystemDfOptions) (*entities.SystemDfReport, error) {
 	dfImages := []*entities.SystemDfImageReport{}
 
 	imageStats, totalImageSize, err := ic.Libpod.LibimageRuntime().DiskUsage(ctx)
@@ -288,12 +294,118 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, _ entities.SystemDfOpti
 		dfVolumes = append(dfVolumes, &report)
 	}
 
-	return &entities.SystemDfReport{
+	report := &entities.SystemDfReport{
 		ImagesSize: totalImageSize,
 		Images:     dfImages,
 		Containers: dfContainers,
 		Volumes:    dfVolumes,
-	}, nil
+	}
+	if !options.Verbose {
+		return report, nil
+	}
+
+	layers, err := ic.Libpod.ImageLayerUsage()
+	if err != nil {
+		return nil, err
+	}
+	report.Layers = make([]*entities.SystemDfLayerReport, 0, len(layers))
+	for _, layer := range layers {
+		report.Layers = append(report.Layers, &entities.SystemDfLayerReport{
+			LayerID: layer.ID,
+			Size:    layer.Size,
+			Images:  layer.Images,
+		})
+	}
+
+	report.Artifacts, report.ArtifactsSize, err = ic.systemDfArtifacts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report.BuildCache, err = ic.systemDfBuildCache(ctx, dfImages)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// systemDfArtifacts returns the disk usage of the artifacts and the size of
+// all blobs in the artifact store.  Blobs shared by several artifacts are
+// only counted once.
+func (ic *ContainerEngine) systemDfArtifacts(ctx context.Context) ([]*entities.SystemDfArtifactReport, int64, error) {
+	artStore, err := ic.Libpod.ArtifactStore()
+	if err != nil {
+		return nil, 0, err
+	}
+	artifacts, err := artStore.List(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	blobCount := make(map[digest.Digest]int)
+	for _, arty := range artifacts {
+		for _, layer := range arty.Manifest.Layers {
+			blobCount[layer.Digest]++
+		}
+	}
+
+	var totalSize int64
+	counted := make(map[digest.Digest]struct{})
+	dfArtifacts := make([]*entities.SystemDfArtifactReport, 0, len(artifacts))
+	for _, arty := range artifacts {
+		artifactDigest, err := arty.GetDigest()
+		if err != nil {
+			return nil, 0, err
+		}
+		report := entities.SystemDfArtifactReport{
+			Name:   arty.Name,
+			Digest: artifactDigest.String(),
+			Size:   arty.TotalSizeBytes(),
+		}
+		for _, layer := range arty.Manifest.Layers {
+			if blobCount[layer.Digest] == 1 {
+				report.UniqueSize += layer.Size
+			}
+			if _, ok := counted[layer.Digest]; !ok {
+				counted[layer.Digest] = struct{}{}
+				totalSize += layer.Size
+			}
+		}
+		dfArtifacts = append(dfArtifacts, &report)
+	}
+	return dfArtifacts, totalSize, nil
+}
+
+// systemDfBuildCache returns the disk usage of the intermediate images and
+// the cache mounts of builds, which are removed by pruning the build cache.
+func (ic *ContainerEngine) systemDfBuildCache(ctx context.Context, dfImages []*entities.SystemDfImageReport) (*entities.SystemDfBuildCacheReport, error) {
+	intermediateImages, err := ic.Libpod.LibimageRuntime().ListImages(ctx, &libimage.ListImagesOptions{Filters: []string{"intermediate=true"}})
+	if err != nil {
+		return nil, err
+	}
+	uniqueSizes := make(map[string]int64, len(dfImages))
+	for _, image := range dfImages {
+		uniqueSizes[image.ImageID] = image.UniqueSize
+	}
+
+	report := entities.SystemDfBuildCacheReport{
+		IntermediateImages: len(intermediateImages),
+	}
+	for _, image := range intermediateImages {
+		report.IntermediateSize += uniqueSizes[image.ID()]
+	}
+
+	// Same location buildah uses for RUN --mount=type=cache
+	cacheMounts := filepath.Join(parse.GetTempDir(), "buildah-cache-"+strconv.Itoa(unshare.GetRootlessUID()))
+	if err := fileutils.Exists(cacheMounts); err == nil {
+		report.CacheMountsSize, err = directory.Size(cacheMounts)
+		if err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return &report, nil
 }
 
 func (ic *ContainerEngine) Reset(ctx context.Context) error {
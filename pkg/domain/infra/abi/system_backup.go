@@ -0,0 +1,434 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	"github.com/containers/podman/v6/version"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/libimage"
+	nettypes "go.podman.io/common/libnetwork/types"
+	"go.podman.io/common/pkg/config"
+	"go.podman.io/common/pkg/secrets"
+	"go.podman.io/storage"
+	"go.podman.io/storage/pkg/archive"
+)
+
+// backupVersion is the version of the backup archive format.
+const backupVersion = 1
+
+// Files in a backup archive.
+const (
+	backupManifestFile   = "backup.json"
+	backupPodsFile       = "pods.json"
+	backupContainersFile = "containers.json"
+	backupVolumesFile    = "volumes.json"
+	backupVolumesDir     = "volumes" // <name>.tar as written by podman volume export
+	backupNetworksFile   = "networks.json"
+	backupSecretsFile    = "secrets.json"
+	backupImagesFile     = "images.tar" // docker-archive
+)
+
+// backupManifest describes a backup archive.
+type backupManifest struct {
+	Version       int       `json:"version"`
+	PodmanVersion string    `json:"podmanVersion"`
+	Created       time.Time `json:"created"`
+	Images        bool      `json:"images"`
+}
+
+// SystemBackup writes the configuration of all pods, containers, volumes and
+// networks, the data of local volumes, the metadata of secrets and
+// optionally all images into a single archive.
+func (ic *ContainerEngine) SystemBackup(ctx context.Context, options entities.SystemBackupOptions) (*entities.SystemBackupReport, error) {
+	dir, err := os.MkdirTemp("", "podman-backup")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	report := new(entities.SystemBackupReport)
+
+	pods, err := ic.Libpod.GetAllPods()
+	if err != nil {
+		return nil, err
+	}
+	podConfigs := make([]*libpod.PodConfig, 0, len(pods))
+	for _, pod := range pods {
+		podConfig, err := pod.Config()
+		if err != nil {
+			return nil, fmt.Errorf("backing up pod %s: %w", pod.Name(), err)
+		}
+		podConfigs = append(podConfigs, podConfig)
+		report.Pods = append(report.Pods, pod.Name())
+	}
+	if err := writeBackupJSON(dir, backupPodsFile, podConfigs); err != nil {
+		return nil, err
+	}
+
+	ctrs, err := ic.Libpod.GetAllContainers()
+	if err != nil {
+		return nil, err
+	}
+	ctrConfigs := make([]*libpod.ContainerConfig, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		ctrConfigs = append(ctrConfigs, ctr.Config())
+		report.Containers = append(report.Containers, ctr.Name())
+	}
+	if err := writeBackupJSON(dir, backupContainersFile, ctrConfigs); err != nil {
+		return nil, err
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, backupVolumesDir), 0o700); err != nil {
+		return nil, err
+	}
+	vols, err := ic.Libpod.GetAllVolumes()
+	if err != nil {
+		return nil, err
+	}
+	volConfigs := make([]*libpod.VolumeConfig, 0, len(vols))
+	for _, vol := range vols {
+		volConfig, err := vol.Config()
+		if err != nil {
+			return nil, fmt.Errorf("backing up volume %s: %w", vol.Name(), err)
+		}
+		volConfigs = append(volConfigs, volConfig)
+		report.Volumes = append(report.Volumes, vol.Name())
+
+		// The data of volumes of other drivers is not managed by Podman.
+		if vol.Driver() != define.VolumeDriverLocal {
+			continue
+		}
+		if err := ic.backupVolumeData(ctx, dir, vol.Name()); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeBackupJSON(dir, backupVolumesFile, volConfigs); err != nil {
+		return nil, err
+	}
+
+	nets, err := ic.Libpod.Network().NetworkList()
+	if err != nil {
+		return nil, err
+	}
+	nets = slices.DeleteFunc(nets, func(n nettypes.Network) bool {
+		return n.Name == ic.Libpod.GetDefaultNetworkName()
+	})
+	for _, n := range nets {
+		report.Networks = append(report.Networks, n.Name)
+	}
+	if err := writeBackupJSON(dir, backupNetworksFile, nets); err != nil {
+		return nil, err
+	}
+
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	secretList, err := manager.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secretList {
+		report.Secrets = append(report.Secrets, secret.Name)
+	}
+	if err := writeBackupJSON(dir, backupSecretsFile, secretList); err != nil {
+		return nil, err
+	}
+
+	if options.Images {
+		ids, err := ic.backupImages(ctx, filepath.Join(dir, backupImagesFile))
+		if err != nil {
+			return nil, err
+		}
+		report.Images = ids
+	}
+
+	manifest := backupManifest{
+		Version:       backupVersion,
+		PodmanVersion: version.Version.String(),
+		Created:       time.Now(),
+		Images:        options.Images,
+	}
+	if err := writeBackupJSON(dir, backupManifestFile, manifest); err != nil {
+		return nil, err
+	}
+
+	tarStream, err := archive.Tar(dir, archive.Uncompressed)
+	if err != nil {
+		return nil, err
+	}
+	defer tarStream.Close()
+	if _, err := io.Copy(options.Output, tarStream); err != nil {
+		return nil, fmt.Errorf("writing backup archive: %w", err)
+	}
+	return report, nil
+}
+
+// backupVolumeData exports the data of the volume into the backup directory.
+func (ic *ContainerEngine) backupVolumeData(ctx context.Context, dir, name string) error {
+	f, err := os.Create(filepath.Join(dir, backupVolumesDir, name+".tar"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := ic.VolumeExport(ctx, name, entities.VolumeExportOptions{Output: f}); err != nil {
+		return fmt.Errorf("backing up volume %s: %w", name, err)
+	}
+	return nil
+}
+
+// backupImages saves all local images into a docker archive at path and
+// returns their IDs.
+func (ic *ContainerEngine) backupImages(ctx context.Context, path string) ([]string, error) {
+	listOptions := &libimage.ListImagesOptions{Filters: []string{"readonly=false"}}
+	images, err := ic.Libpod.LibimageRuntime().ListImages(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	if len(images) == 0 {
+		return nil, nil
+	}
+
+	var ids, names []string
+	for _, image := range images {
+		ids = append(ids, image.ID())
+		// Save the image by its names to restore them.
+		if imageNames := image.Names(); len(imageNames) > 0 {
+			names = append(names, imageNames...)
+		} else {
+			names = append(names, image.ID())
+		}
+	}
+	if err := ic.Libpod.LibimageRuntime().Save(ctx, names, "docker-archive", path, &libimage.SaveOptions{}); err != nil {
+		return nil, fmt.Errorf("backing up images: %w", err)
+	}
+	return ids, nil
+}
+
+// writeBackupJSON writes v as JSON into the file of the backup directory.
+func writeBackupJSON(dir, file string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, file), b, 0o600)
+}
+
+// readBackupJSON reads the JSON file of the backup directory into v.
+func readBackupJSON(dir, file string, v any) error {
+	b, err := os.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		return fmt.Errorf("reading backup: %w", err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("parsing backup file %s: %w", file, err)
+	}
+	return nil
+}
+
+// SystemRestore recreates the objects of a backup archive written by
+// SystemBackup.  Pods, containers and volumes keep their IDs and names but
+// are assigned new locks.  Objects which cannot be restored, for instance
+// because they already exist, are reported as errors without aborting the
+// restore.
+func (ic *ContainerEngine) SystemRestore(ctx context.Context, options entities.SystemRestoreOptions) (*entities.SystemRestoreReport, error) {
+	dir, err := os.MkdirTemp("", "podman-restore")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := archive.Untar(options.Input, dir, &archive.TarOptions{NoLchown: true}); err != nil {
+		return nil, fmt.Errorf("extracting backup archive: %w", err)
+	}
+
+	var manifest backupManifest
+	if err := readBackupJSON(dir, backupManifestFile, &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Version > backupVersion {
+		return nil, fmt.Errorf("backup has been created by Podman %s with unsupported format version %d", manifest.PodmanVersion, manifest.Version)
+	}
+
+	var (
+		podConfigs []*libpod.PodConfig
+		ctrConfigs []*libpod.ContainerConfig
+		volConfigs []*libpod.VolumeConfig
+		nets       []nettypes.Network
+		secretList []secrets.Secret
+	)
+	for file, v := range map[string]any{
+		backupPodsFile:       &podConfigs,
+		backupContainersFile: &ctrConfigs,
+		backupVolumesFile:    &volConfigs,
+		backupNetworksFile:   &nets,
+		backupSecretsFile:    &secretList,
+	} {
+		if err := readBackupJSON(dir, file, v); err != nil {
+			return nil, err
+		}
+	}
+
+	report := new(entities.SystemRestoreReport)
+	var errs []error
+
+	if manifest.Images {
+		names, err := ic.Libpod.LibimageRuntime().Load(ctx, filepath.Join(dir, backupImagesFile), &libimage.LoadOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("restoring images: %w", err))
+		}
+		report.Images = names
+	}
+
+	// Networks existing on this host already are kept as they are.
+	for _, n := range nets {
+		if _, err := ic.Libpod.Network().NetworkCreate(n, &nettypes.NetworkCreateOptions{IgnoreIfExists: true}); err != nil {
+			errs = append(errs, fmt.Errorf("restoring network %s: %w", n.Name, err))
+			continue
+		}
+		report.Networks = append(report.Networks, n.Name)
+	}
+
+	// The data of secrets is not backed up, so they must be created on
+	// this host before restoring containers using them.
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secretList {
+		if _, err := manager.Lookup(secret.Name); err != nil {
+			logrus.Warnf("Secret %s of the backup does not exist, containers using it cannot be restored", secret.Name)
+		}
+	}
+
+	for _, volConfig := range volConfigs {
+		if err := ic.restoreVolume(ctx, dir, volConfig); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		report.Volumes = append(report.Volumes, volConfig.Name)
+	}
+
+	for _, podConfig := range podConfigs {
+		if _, err := ic.Libpod.RestorePod(ctx, podConfig); err != nil {
+			errs = append(errs, fmt.Errorf("restoring pod %s: %w", podConfig.Name, err))
+			continue
+		}
+		report.Pods = append(report.Pods, podConfig.Name)
+	}
+
+	// Restore infra and service containers first as other containers
+	// depend on them.  Containers depending on containers which have not
+	// been restored yet are retried until no more progress is made.
+	slices.SortStableFunc(ctrConfigs, func(a, b *libpod.ContainerConfig) int {
+		aFirst, bFirst := a.IsInfra || a.IsService, b.IsInfra || b.IsService
+		switch {
+		case aFirst && !bFirst:
+			return -1
+		case !aFirst && bFirst:
+			return 1
+		default:
+			return 0
+		}
+	})
+	pending := ctrConfigs
+	for len(pending) > 0 {
+		var (
+			deferred     []*libpod.ContainerConfig
+			deferredErrs []error
+		)
+		for _, ctrConfig := range pending {
+			if err := ic.restoreContainer(ctx, ctrConfig); err != nil {
+				err = fmt.Errorf("restoring container %s: %w", ctrConfig.Name, err)
+				if errors.Is(err, define.ErrNoSuchCtr) {
+					deferred = append(deferred, ctrConfig)
+					deferredErrs = append(deferredErrs, err)
+				} else {
+					errs = append(errs, err)
+				}
+				continue
+			}
+			report.Containers = append(report.Containers, ctrConfig.Name)
+		}
+		if len(deferred) == len(pending) {
+			errs = append(errs, deferredErrs...)
+			break
+		}
+		pending = deferred
+	}
+
+	if len(errs) > 0 {
+		return report, errorhandling.JoinErrors(errs)
+	}
+	return report, nil
+}
+
+// restoreVolume recreates the volume and imports its data from the backup
+// directory.
+func (ic *ContainerEngine) restoreVolume(ctx context.Context, dir string, volConfig *libpod.VolumeConfig) error {
+	vol, err := ic.Libpod.RestoreVolume(ctx, volConfig)
+	if err != nil {
+		return fmt.Errorf("restoring volume %s: %w", volConfig.Name, err)
+	}
+	if vol.Driver() != define.VolumeDriverLocal {
+		return nil
+	}
+
+	f, err := os.Open(filepath.Join(dir, backupVolumesDir, vol.Name()+".tar"))
+	if err != nil {
+		return fmt.Errorf("restoring volume %s: %w", vol.Name(), err)
+	}
+	defer f.Close()
+	if err := vol.Import(f); err != nil {
+		return fmt.Errorf("restoring volume %s: %w", vol.Name(), err)
+	}
+	return nil
+}
+
+// restoreContainer recreates the container from its configuration.  If its
+// image does not exist, it is pulled.
+func (ic *ContainerEngine) restoreContainer(ctx context.Context, ctrConfig *libpod.ContainerConfig) error {
+	if ctrConfig.RootfsImageID != "" {
+		_, _, err := ic.Libpod.LibimageRuntime().LookupImage(ctrConfig.RootfsImageID, nil)
+		if err != nil {
+			if !errors.Is(err, storage.ErrImageUnknown) || ctrConfig.RootfsImageName == "" {
+				return err
+			}
+			pullOptions := &libimage.PullOptions{}
+			pullOptions.Writer = os.Stderr
+			pulled, err := ic.Libpod.LibimageRuntime().Pull(ctx, ctrConfig.RootfsImageName, config.PullPolicyMissing, pullOptions)
+			if err != nil {
+				return fmt.Errorf("pulling image of container: %w", err)
+			}
+			ctrConfig.RootfsImageID = pulled[0].ID()
+		}
+	}
+
+	ctr, err := ic.Libpod.RestoreContainer(ctx, ctrConfig.Spec, ctrConfig)
+	if err != nil {
+		return err
+	}
+	if !ctr.IsInfra() {
+		return nil
+	}
+	pod, err := ic.Libpod.LookupPod(ctrConfig.Pod)
+	if err != nil {
+		return err
+	}
+	_, err = ic.Libpod.AddInfra(ctx, pod, ctr)
+	return err
+}
@@ -277,3 +277,50 @@ func (ic *ContainerEngine) VolumeImport(_ context.Context, nameOrID string, opti
 
 	return nil
 }
+
+func (ic *ContainerEngine) VolumeSnapshot(ctx context.Context, nameOrID string, options entities.VolumeSnapshotOptions) (*entities.IDOrNameResponse, error) {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := ic.Libpod.SnapshotVolume(ctx, vol, options.Name, options.Force)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IDOrNameResponse{IDOrName: snapshot.Name()}, nil
+}
+
+func (ic *ContainerEngine) VolumeClone(ctx context.Context, nameOrID string, options entities.VolumeCloneOptions) (*entities.IDOrNameResponse, error) {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := ic.Libpod.CloneVolume(ctx, vol, options.Name, options.Force)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IDOrNameResponse{IDOrName: clone.Name()}, nil
+}
+
+func (ic *ContainerEngine) VolumeRollback(ctx context.Context, nameOrID string, options entities.VolumeRollbackOptions) (*entities.IDOrNameResponse, error) {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot *libpod.Volume
+	if options.Snapshot != "" {
+		snapshot, err = ic.Libpod.LookupVolume(options.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	restored, err := ic.Libpod.RollbackVolume(ctx, vol, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IDOrNameResponse{IDOrName: restored.Name()}, nil
+}
@@ -96,16 +96,28 @@ func (ir *ImageEngine) ArtifactAdd(_ context.Context, name string, artifactBlob
 		ArtifactMIMEType: &opts.ArtifactMIMEType,
 		FileMIMEType:     &opts.FileMIMEType,
 		Replace:          &opts.Replace,
+		ReplaceFile:      &opts.ReplaceFile,
 	}
 
 	for k, v := range opts.Annotations {
 		options.Annotations = append(options.Annotations, k+"="+v)
 	}
 
+	// Files are added one by one, so make sure all of them exist before
+	// the artifact is changed.
+	for _, blob := range artifactBlob {
+		if _, err := os.Stat(blob.BlobFilePath); err != nil {
+			return nil, err
+		}
+	}
+
 	for i, blob := range artifactBlob {
-		if i > 0 {
-			// When adding more than 1 blob, set append true after the first
+		if i > 0 && !opts.ReplaceFile {
+			// When adding more than 1 blob, set append true after the first.
+			// An artifact replaced by the first blob must not be replaced
+			// again, files are replaced one by one with ReplaceFile.
 			options.WithAppend(true)
+			options.WithReplace(false)
 		}
 
 		isWSL, err := localapi.IsWSLProvider(ir.ClientCtx)
@@ -123,10 +135,10 @@ func (ir *ImageEngine) ArtifactAdd(_ context.Context, name string, artifactBlob
 					switch errModel.ResponseCode {
 					case http.StatusNotFound, http.StatusMethodNotAllowed:
 					default:
-						return nil, artifactAddErrorCleanup(ir.ClientCtx, i, name, err)
+						return nil, artifactAddErrorCleanup(ir.ClientCtx, i, name, &options, err)
 					}
 				} else {
-					return nil, artifactAddErrorCleanup(ir.ClientCtx, i, name, err)
+					return nil, artifactAddErrorCleanup(ir.ClientCtx, i, name, &options, err)
 				}
 			}
 		}
@@ -139,8 +151,10 @@ func (ir *ImageEngine) ArtifactAdd(_ context.Context, name string, artifactBlob
 	return artifactAddReport, nil
 }
 
-func artifactAddErrorCleanup(ctx context.Context, index int, name string, err error) error {
-	if index == 0 {
+func artifactAddErrorCleanup(ctx context.Context, index int, name string, options *artifacts.AddOptions, err error) error {
+	// Replacing files keeps the artifact, the files replaced before are
+	// complete.
+	if index == 0 || options.GetReplaceFile() {
 		return err
 	}
 	removeOptions := artifacts.RemoveOptions{
@@ -162,7 +176,7 @@ func addArtifact(ctx context.Context, name string, index int, blob entities.Arti
 
 	artifactAddReport, err := artifacts.Add(ctx, name, blob.FileName, f, options)
 	if err != nil {
-		return nil, artifactAddErrorCleanup(ctx, index, name, err)
+		return nil, artifactAddErrorCleanup(ctx, index, name, options, err)
 	}
 	return artifactAddReport, nil
 }
@@ -2,11 +2,29 @@ package tunnel
 
 import (
 	"context"
-	"errors"
+	"math"
+	"time"
 
+	"github.com/containers/podman/v6/pkg/bindings/system"
 	"github.com/containers/podman/v6/pkg/domain/entities"
+	"go.podman.io/image/v5/types"
 )
 
-func (ic *ContainerEngine) AutoUpdate(_ context.Context, _ entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
-	return nil, []error{errors.New("not implemented")}
+func (ic *ContainerEngine) AutoUpdate(_ context.Context, opts entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
+	options := new(system.AutoUpdateOptions).WithAuthfile(opts.Authfile).WithDryRun(opts.DryRun).WithRollback(opts.Rollback)
+	options = options.WithWaitHealthy(durationToSeconds(opts.WaitHealthy)).WithSoakTime(durationToSeconds(opts.SoakTime))
+	options = options.WithCanary(opts.Canary).WithBatchSize(uint(max(opts.BatchSize, 0)))
+	if s := opts.InsecureSkipTLSVerify; s != types.OptionalBoolUndefined {
+		options = options.WithSkipTLSVerify(s == types.OptionalBoolTrue)
+	}
+	return system.AutoUpdate(ic.ClientCtx, options)
+}
+
+// durationToSeconds converts d to the whole seconds of the API, rounding up
+// such that a short duration is not turned into zero.
+func durationToSeconds(d time.Duration) uint {
+	if d <= 0 {
+		return 0
+	}
+	return uint(math.Ceil(d.Seconds()))
 }
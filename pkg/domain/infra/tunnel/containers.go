@@ -453,7 +453,7 @@ func (ic *ContainerEngine) ContainerCheckpoint(_ context.Context, namesOrIds []s
 }
 
 func (ic *ContainerEngine) ContainerRestore(_ context.Context, namesOrIds []string, opts entities.RestoreOptions) ([]*entities.RestoreReport, error) {
-	if opts.ImportPrevious != "" {
+	if opts.ImportPrevious != "" || len(opts.ImportPreviousChain) > 0 {
 		return nil, fmt.Errorf("--import-previous is not supported on the remote client")
 	}
 
@@ -551,6 +551,12 @@ func (ic *ContainerEngine) ContainerLogs(_ context.Context, nameOrIDs []string,
 	stderr := opts.StderrWriter != nil
 	options := new(containers.LogOptions).WithFollow(opts.Follow).WithSince(since).WithUntil(until).WithStderr(stderr)
 	options.WithStdout(stdout).WithTail(tail).WithTimestamps(opts.Timestamps)
+	if opts.Grep != "" {
+		options.WithGrep(opts.Grep)
+	}
+	if len(opts.Filters) > 0 {
+		options.WithFilters(opts.Filters)
+	}
 
 	var err error
 	stdoutCh := make(chan string)
@@ -1018,6 +1024,14 @@ func (ic *ContainerEngine) ContainerInit(_ context.Context, namesOrIds []string,
 	return reports, nil
 }
 
+func (ic *ContainerEngine) ContainerMigrate(_ context.Context, _, _ string, _ entities.ContainerMigrateOptions) (*entities.ContainerMigrateReport, error) {
+	return nil, errors.New("migrating containers is not supported for remote clients")
+}
+
+func (ic *ContainerEngine) ContainerRotateLogs(_ context.Context, _ []string, _ entities.ContainerRotateLogsOptions) ([]*entities.ContainerRotateLogsReport, error) {
+	return nil, errors.New("rotating container logs is not supported for remote clients")
+}
+
 func (ic *ContainerEngine) ContainerMount(_ context.Context, _ []string, _ entities.ContainerMountOptions) ([]*entities.ContainerMountReport, error) {
 	return nil, errors.New("mounting containers is not supported for remote clients")
 }
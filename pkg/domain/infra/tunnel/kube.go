@@ -2,6 +2,7 @@ package tunnel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -76,6 +77,9 @@ func (ic *ContainerEngine) PlayKube(_ context.Context, body io.Reader, opts enti
 	options.WithPublishAllPorts(opts.PublishAllPorts)
 	options.WithNoTrunc(opts.UseLongAnnotations)
 	options.WithNoPodPrefix(opts.NoPodPrefix)
+	if opts.ReadyTimeout > 0 {
+		options.WithReadyTimeout(durationToSeconds(opts.ReadyTimeout))
+	}
 	return play.KubeWithBody(ic.ClientCtx, body, options)
 }
 
@@ -83,6 +87,10 @@ func (ic *ContainerEngine) PlayKubeDown(_ context.Context, body io.Reader, optio
 	return play.DownWithBody(ic.ClientCtx, body, kube.DownOptions{Force: &options.Force})
 }
 
+func (ic *ContainerEngine) KubeCronJobRun(_ context.Context, _ string, _ entities.KubeCronJobRunOptions) error {
+	return errors.New("running CronJobs is not supported on the remote client")
+}
+
 func (ic *ContainerEngine) KubeApply(_ context.Context, body io.Reader, opts entities.ApplyOptions) error {
 	options := new(kube.ApplyOptions).WithKubeconfig(opts.Kubeconfig).WithCACertFile(opts.CACertFile).WithNamespace(opts.Namespace)
 	return kube.ApplyWithBody(ic.ClientCtx, body, options)
@@ -75,6 +75,19 @@ func (ic *ContainerEngine) PodPause(_ context.Context, namesOrIds []string, opti
 	return reports, nil
 }
 
+// PodUpdate updates the resource limits, restart policy and labels of the given pod
+func (ic *ContainerEngine) PodUpdate(_ context.Context, options *entities.PodUpdateOptions) (string, error) {
+	return pods.Update(ic.ClientCtx, options)
+}
+
+func (ic *ContainerEngine) PodCheckpoint(_ context.Context, _ string, _ entities.PodCheckpointOptions) (*entities.PodCheckpointReport, error) {
+	return nil, errors.New("pod checkpoint is not supported on remote clients")
+}
+
+func (ic *ContainerEngine) PodRestore(_ context.Context, _ entities.PodRestoreOptions) (*entities.PodRestoreReport, error) {
+	return nil, errors.New("pod restore is not supported on remote clients")
+}
+
 func (ic *ContainerEngine) PodUnpause(_ context.Context, namesOrIds []string, options entities.PodunpauseOptions) ([]*entities.PodUnpauseReport, error) {
 	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, false, namesOrIds)
 	if err != nil {
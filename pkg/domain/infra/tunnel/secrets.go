@@ -25,6 +25,15 @@ func (ic *ContainerEngine) SecretCreate(_ context.Context, name string, reader i
 	return created, nil
 }
 
+func (ic *ContainerEngine) SecretUpdate(_ context.Context, nameOrID string, reader io.Reader, options entities.SecretUpdateOptions) (*entities.SecretUpdateReport, error) {
+	opts := new(secrets.UpdateOptions).
+		WithRestart(options.Restart)
+	if options.Signal != "" {
+		opts.WithSignal(options.Signal)
+	}
+	return secrets.Update(ic.ClientCtx, nameOrID, reader, opts)
+}
+
 func (ic *ContainerEngine) SecretInspect(_ context.Context, nameOrIDs []string, options entities.SecretInspectOptions) ([]*entities.SecretInfoReport, []error, error) {
 	allInspect := make([]*entities.SecretInfoReport, 0, len(nameOrIDs))
 	errs := make([]error, 0, len(nameOrIDs))
@@ -44,8 +44,16 @@ func (ic *ContainerEngine) Reset(_ context.Context) error {
 	return errors.New("system reset is not supported on remote clients")
 }
 
-func (ic *ContainerEngine) SystemDf(_ context.Context, _ entities.SystemDfOptions) (*entities.SystemDfReport, error) {
-	return system.DiskUsage(ic.ClientCtx, nil)
+func (ic *ContainerEngine) SystemBackup(_ context.Context, _ entities.SystemBackupOptions) (*entities.SystemBackupReport, error) {
+	return nil, errors.New("system backup is not supported on remote clients")
+}
+
+func (ic *ContainerEngine) SystemRestore(_ context.Context, _ entities.SystemRestoreOptions) (*entities.SystemRestoreReport, error) {
+	return nil, errors.New("system restore is not supported on remote clients")
+}
+
+func (ic *ContainerEngine) SystemDf(_ context.Context, options entities.SystemDfOptions) (*entities.SystemDfReport, error) {
+	return system.DiskUsage(ic.ClientCtx, new(system.DiskOptions).WithVerbose(options.Verbose))
 }
 
 func (ic *ContainerEngine) Unshare(_ context.Context, _ []string, _ entities.SystemUnshareOptions) error {
@@ -121,3 +121,35 @@ func (ic *ContainerEngine) VolumeExport(_ context.Context, nameOrID string, opti
 func (ic *ContainerEngine) VolumeImport(_ context.Context, nameOrID string, options entities.VolumeImportOptions) error {
 	return volumes.Import(ic.ClientCtx, nameOrID, options.Input)
 }
+
+func (ic *ContainerEngine) VolumeSnapshot(_ context.Context, nameOrID string, options entities.VolumeSnapshotOptions) (*entities.IDOrNameResponse, error) {
+	snapshotOptions := new(volumes.SnapshotOptions).WithForce(options.Force)
+	if options.Name != "" {
+		snapshotOptions.WithName(options.Name)
+	}
+	response, err := volumes.Snapshot(ic.ClientCtx, nameOrID, snapshotOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IDOrNameResponse{IDOrName: response.Name}, nil
+}
+
+func (ic *ContainerEngine) VolumeClone(_ context.Context, nameOrID string, options entities.VolumeCloneOptions) (*entities.IDOrNameResponse, error) {
+	response, err := volumes.Clone(ic.ClientCtx, nameOrID, new(volumes.CloneOptions).WithName(options.Name).WithForce(options.Force))
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IDOrNameResponse{IDOrName: response.Name}, nil
+}
+
+func (ic *ContainerEngine) VolumeRollback(_ context.Context, nameOrID string, options entities.VolumeRollbackOptions) (*entities.IDOrNameResponse, error) {
+	rollbackOptions := new(volumes.RollbackOptions)
+	if options.Snapshot != "" {
+		rollbackOptions.WithSnapshot(options.Snapshot)
+	}
+	response, err := volumes.Rollback(ic.ClientCtx, nameOrID, rollbackOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IDOrNameResponse{IDOrName: response.Name}, nil
+}
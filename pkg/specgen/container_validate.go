@@ -92,6 +92,14 @@ func (s *SpecGenerator) Validate() error {
 		return fmt.Errorf("IDMappings are required when not creating a User namespace: %w", ErrInvalidSpecConfig)
 	}
 
+	//
+	// ContainerHealthCheckConfig
+	//
+	// the on-failure hook is only run by the notify action
+	if s.HealthCheckOnFailureHook != "" && s.HealthCheckOnFailureAction != define.HealthCheckOnFailureActionNotify {
+		return fmt.Errorf("--health-on-failure-hook requires --health-on-failure=notify: %w", ErrInvalidSpecConfig)
+	}
+
 	//
 	// ContainerCgroupConfig
 	//
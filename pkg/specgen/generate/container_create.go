@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/libpod/define"
@@ -385,6 +387,9 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 	if s.BaseHostsFile != "" {
 		options = append(options, libpod.WithBaseHostsFile(s.BaseHostsFile))
 	}
+	if len(s.EgressAllow) > 0 {
+		options = append(options, libpod.WithEgressAllow(s.EgressAllow))
+	}
 
 	if s.IsPrivileged() {
 		options = append(options, libpod.WithMountAllDevices())
@@ -562,6 +567,13 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		if len(s.LogConfiguration.Options) > 0 && s.LogConfiguration.Options["tag"] != "" {
 			options = append(options, libpod.WithLogTag(s.LogConfiguration.Options["tag"]))
 		}
+		rotationOption, err := logRotationOption(s.LogConfiguration.Options)
+		if err != nil {
+			return nil, err
+		}
+		if rotationOption != nil {
+			options = append(options, rotationOption)
+		}
 
 		if len(s.LogConfiguration.Driver) > 0 {
 			options = append(options, libpod.WithLogDriver(s.LogConfiguration.Driver))
@@ -657,6 +669,10 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		options = append(options, libpod.WithHealthCheckOnFailureAction(s.ContainerHealthCheckConfig.HealthCheckOnFailureAction))
 	}
 
+	if s.ContainerHealthCheckConfig.HealthCheckOnFailureHook != "" {
+		options = append(options, libpod.WithHealthCheckOnFailureHook(s.ContainerHealthCheckConfig.HealthCheckOnFailureHook))
+	}
+
 	options = append(options, libpod.WithHealthCheckLogDestination(s.ContainerHealthCheckConfig.HealthLogDestination))
 	options = append(options, libpod.WithHealthCheckMaxLogCount(s.ContainerHealthCheckConfig.HealthMaxLogCount))
 	options = append(options, libpod.WithHealthCheckMaxLogSize(s.ContainerHealthCheckConfig.HealthMaxLogSize))
@@ -702,6 +718,17 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		}
 		options = append(options, libpod.WithDependencyCtrs(deps))
 	}
+	if len(s.HealthyDependencyContainers) > 0 {
+		deps := make([]*libpod.Container, 0, len(s.HealthyDependencyContainers))
+		for _, ctr := range s.HealthyDependencyContainers {
+			depCtr, err := rt.LookupContainer(ctr)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a valid container, cannot be used as a dependency: %w", ctr, err)
+			}
+			deps = append(deps, depCtr)
+		}
+		options = append(options, libpod.WithHealthyDependencyCtrs(deps, s.HealthyDependencyTimeout))
+	}
 	if s.PidFile != "" {
 		options = append(options, libpod.WithPidFile(s.PidFile))
 	}
@@ -715,6 +742,40 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 	return options, nil
 }
 
+// logRotationOption returns the option enabling the rotation of the log
+// file set by the max-file, max-age and compress log options, if any.
+func logRotationOption(logOpts map[string]string) (libpod.CtrCreateOption, error) {
+	maxFile, ok := logOpts["max-file"]
+	if !ok {
+		if _, ok := logOpts["max-age"]; ok {
+			return nil, errors.New("log option max-age requires max-file to be set")
+		}
+		if _, ok := logOpts["compress"]; ok {
+			return nil, errors.New("log option compress requires max-file to be set")
+		}
+		return nil, nil
+	}
+	maxFiles, err := strconv.ParseUint(maxFile, 10, 32)
+	if err != nil || maxFiles == 0 {
+		return nil, fmt.Errorf("invalid log option max-file %q, must be a positive number", maxFile)
+	}
+	var maxAge time.Duration
+	if value, ok := logOpts["max-age"]; ok {
+		maxAge, err = time.ParseDuration(value)
+		if err != nil || maxAge <= 0 {
+			return nil, fmt.Errorf("invalid log option max-age %q, must be a positive duration", value)
+		}
+	}
+	compress := false
+	if value, ok := logOpts["compress"]; ok {
+		compress, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log option compress %q: %w", value, err)
+		}
+	}
+	return libpod.WithLogRotation(uint(maxFiles), maxAge, compress), nil
+}
+
 func Inherit(infra *libpod.Container, s *specgen.SpecGenerator, rt *libpod.Runtime) (opts []libpod.CtrCreateOption, infraS *specs.Spec, compat *libpod.InfraInherit, err error) {
 	inheritSpec := &specgen.SpecGenerator{}
 	_, compatibleOptions, err := ConfigToSpec(rt, inheritSpec, infra.ID())
@@ -0,0 +1,262 @@
+//go:build !remote
+
+package kube
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField describes the valid range and names of a field of a cron
+// schedule.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// systemd's names of the days of the week, starting with Sunday as cron does.
+var systemdWeekdays = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// CronSchedule is a parsed standard cron schedule of a Kubernetes CronJob.
+type CronSchedule struct {
+	minute  []bool
+	hour    []bool
+	dom     []bool
+	month   []bool
+	dow     []bool
+	anyDom  bool
+	anyDow  bool
+	spec    string
+	loc     *time.Location
+	zone    string
+	hasZone bool
+}
+
+// ParseCronSchedule parses the five-field cron schedule of a CronJob along
+// with its optional time zone.  The @yearly, @monthly, @weekly, @daily,
+// @midnight and @hourly macros are supported.
+func ParseCronSchedule(schedule string, timeZone *string) (*CronSchedule, error) {
+	spec := strings.TrimSpace(schedule)
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("invalid cron schedule %q: expected %d fields but got %d", schedule, len(cronFields), len(fields))
+	}
+
+	s := &CronSchedule{spec: schedule, loc: time.Local}
+	if timeZone != nil && *timeZone != "" {
+		loc, err := time.LoadLocation(*timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", *timeZone, err)
+		}
+		s.loc = loc
+		s.zone = *timeZone
+		s.hasZone = true
+	}
+
+	sets := make([][]bool, len(cronFields))
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron schedule %q: %w", schedule, err)
+		}
+		sets[i] = set
+	}
+	s.minute, s.hour, s.dom, s.month, s.dow = sets[0], sets[1], sets[2], sets[3], sets[4]
+	// Sunday may be written as 0 or 7.
+	if s.dow[7] {
+		s.dow[0] = true
+	}
+	s.dow = s.dow[:7]
+	s.anyDom = strings.HasPrefix(fields[2], "*")
+	s.anyDow = strings.HasPrefix(fields[4], "*")
+
+	return s, nil
+}
+
+// parseCronField parses a single comma-separated field into the set of
+// matching values.
+func parseCronField(field string, desc cronField) ([]bool, error) {
+	set := make([]bool, desc.max+1)
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step %q in %s field", stepPart, desc.name)
+			}
+		}
+
+		var low, high int
+		switch {
+		case rangePart == "*":
+			low, high = desc.min, desc.max
+		case strings.Contains(rangePart, "-"):
+			lowPart, highPart, _ := strings.Cut(rangePart, "-")
+			var err error
+			if low, err = parseCronValue(lowPart, desc); err != nil {
+				return nil, err
+			}
+			if high, err = parseCronValue(highPart, desc); err != nil {
+				return nil, err
+			}
+			if low > high {
+				return nil, fmt.Errorf("invalid range %q in %s field", rangePart, desc.name)
+			}
+		default:
+			var err error
+			if low, err = parseCronValue(rangePart, desc); err != nil {
+				return nil, err
+			}
+			high = low
+			// "5/10" means every 10 starting at 5.
+			if hasStep {
+				high = desc.max
+			}
+		}
+
+		for i := low; i <= high; i += step {
+			set[i] = true
+		}
+	}
+	return set, nil
+}
+
+func parseCronValue(value string, desc cronField) (int, error) {
+	for i, name := range desc.names {
+		if name != "" && strings.EqualFold(value, name) {
+			return i, nil
+		}
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil || i < desc.min || i > desc.max {
+		return 0, fmt.Errorf("invalid value %q in %s field: must be between %d and %d", value, desc.name, desc.min, desc.max)
+	}
+	return i, nil
+}
+
+// matchesDay follows the cron semantics of the day fields: if both, the day
+// of month and the day of week are restricted, either of them must match.
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	dom := s.dom[t.Day()]
+	dow := s.dow[int(t.Weekday())]
+	if s.anyDom || s.anyDow {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next returns the first time after t matching the schedule.  The zero time
+// is returned if the schedule never matches (e.g., on February 30).
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.In(s.loc).Truncate(time.Minute).Add(time.Minute)
+	// Four years cover all combinations of days, including leap years.
+	limit := t.AddDate(4, 0, 1)
+	for t.Before(limit) {
+		if !s.month[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.hour[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.minute[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// OnCalendar returns the schedule as systemd calendar event expressions (see
+// systemd.time(7)) suitable for OnCalendar= of a timer.  Two expressions are
+// returned if both day fields are restricted since systemd, unlike cron,
+// requires all of them to match.
+func (s *CronSchedule) OnCalendar() []string {
+	month := systemdCalendarValues(s.month, 1, "%02d")
+	hour := systemdCalendarValues(s.hour, 0, "%02d")
+	minute := systemdCalendarValues(s.minute, 0, "%02d")
+
+	event := func(weekdays, dom string) string {
+		e := fmt.Sprintf("*-%s-%s %s:%s:00", month, dom, hour, minute)
+		if weekdays != "" {
+			e = weekdays + " " + e
+		}
+		if s.hasZone {
+			e += " " + s.zone
+		}
+		return e
+	}
+
+	var weekdays []string
+	for i, set := range s.dow {
+		if set {
+			weekdays = append(weekdays, systemdWeekdays[i])
+		}
+	}
+	dow := ""
+	if len(weekdays) < len(systemdWeekdays) {
+		dow = strings.Join(weekdays, ",")
+	}
+	dom := systemdCalendarValues(s.dom, 1, "%02d")
+
+	if s.anyDom || s.anyDow {
+		return []string{event(dow, dom)}
+	}
+	return []string{event("", dom), event(dow, "*")}
+}
+
+// String returns the original schedule.
+func (s *CronSchedule) String() string {
+	return s.spec
+}
+
+// systemdCalendarValues renders the set as comma-separated list or as "*"
+// if all values starting at min are set.
+func systemdCalendarValues(set []bool, minValue int, format string) string {
+	var values []string
+	all := true
+	for i := minValue; i < len(set); i++ {
+		if set[i] {
+			values = append(values, fmt.Sprintf(format, i))
+		} else {
+			all = false
+		}
+	}
+	if all {
+		return "*"
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,123 @@
+//go:build !remote
+
+package kube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	utc := "UTC"
+	tests := []struct {
+		name       string
+		schedule   string
+		expectErr  bool
+		onCalendar []string
+		after      string
+		next       string
+	}{
+		{
+			name:       "EveryMinute",
+			schedule:   "* * * * *",
+			onCalendar: []string{"*-*-* *:*:00 UTC"},
+			after:      "2025-01-01T10:00:30Z",
+			next:       "2025-01-01T10:01:00Z",
+		},
+		{
+			name:       "Nightly",
+			schedule:   "30 2 * * *",
+			onCalendar: []string{"*-*-* 02:30:00 UTC"},
+			after:      "2025-01-01T10:00:00Z",
+			next:       "2025-01-02T02:30:00Z",
+		},
+		{
+			name:       "Macro",
+			schedule:   "@hourly",
+			onCalendar: []string{"*-*-* *:00:00 UTC"},
+			after:      "2025-01-01T10:00:00Z",
+			next:       "2025-01-01T11:00:00Z",
+		},
+		{
+			name:       "StepAndNamedWeekdays",
+			schedule:   "*/15 9-17 * * mon-fri",
+			onCalendar: []string{"Mon,Tue,Wed,Thu,Fri *-*-* 09,10,11,12,13,14,15,16,17:00,15,30,45:00 UTC"},
+			after:      "2025-01-03T17:50:00Z", // a Friday
+			next:       "2025-01-06T09:00:00Z",
+		},
+		{
+			name:       "SundayAsSeven",
+			schedule:   "0 0 * * 7",
+			onCalendar: []string{"Sun *-*-* 00:00:00 UTC"},
+			after:      "2025-01-01T00:00:00Z",
+			next:       "2025-01-05T00:00:00Z",
+		},
+		{
+			name:       "DayOfMonthOrWeekday",
+			schedule:   "0 0 13 * 5",
+			onCalendar: []string{"*-*-13 00:00:00 UTC", "Fri *-*-* 00:00:00 UTC"},
+			after:      "2025-01-04T00:00:00Z",
+			next:       "2025-01-10T00:00:00Z",
+		},
+		{
+			name:       "NeverMatches",
+			schedule:   "0 0 30 2 *",
+			onCalendar: []string{"*-02-30 00:00:00 UTC"},
+			after:      "2025-01-01T00:00:00Z",
+			next:       "",
+		},
+		{
+			name:      "TooFewFields",
+			schedule:  "* * * *",
+			expectErr: true,
+		},
+		{
+			name:      "OutOfRange",
+			schedule:  "60 * * * *",
+			expectErr: true,
+		},
+		{
+			name:      "InvalidStep",
+			schedule:  "*/0 * * * *",
+			expectErr: true,
+		},
+		{
+			name:      "InvalidRange",
+			schedule:  "* 5-1 * * *",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := ParseCronSchedule(test.schedule, &utc)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.onCalendar, s.OnCalendar())
+
+			after, err := time.Parse(time.RFC3339, test.after)
+			assert.NoError(t, err)
+			next := s.Next(after)
+			if test.next == "" {
+				assert.True(t, next.IsZero())
+				return
+			}
+			assert.Equal(t, test.next, next.UTC().Format(time.RFC3339))
+		})
+	}
+}
+
+func TestParseCronScheduleTimeZone(t *testing.T) {
+	invalid := "Not/AZone"
+	_, err := ParseCronSchedule("* * * * *", &invalid)
+	assert.Error(t, err)
+
+	s, err := ParseCronSchedule("0 0 * * *", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"*-*-* 00:00:00"}, s.OnCalendar())
+}
@@ -144,6 +144,12 @@ type CtrSpecGenOptions struct {
 	Volumes map[string]*KubeVolume
 	// VolumesFrom for all containers
 	VolumesFrom []string
+	// HealthyDependencies are the containers which must be healthy before
+	// this container is started
+	HealthyDependencies []string
+	// HealthyDependencyTimeout is the maximum time to wait for the
+	// HealthyDependencies to become healthy
+	HealthyDependencyTimeout time.Duration
 	// Image Volumes for this container
 	ImageVolumes []*specgen.ImageVolume
 	// PodID of the parent pod
@@ -610,6 +616,8 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 	}
 
 	s.VolumesFrom = opts.VolumesFrom
+	s.HealthyDependencyContainers = opts.HealthyDependencies
+	s.HealthyDependencyTimeout = opts.HealthyDependencyTimeout
 
 	s.RestartPolicy = opts.RestartPolicy
 
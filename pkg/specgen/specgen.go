@@ -5,6 +5,7 @@ import (
 	"net"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/containers/podman/v6/libpod/define"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
@@ -192,6 +193,16 @@ type ContainerBasicConfig struct {
 	// container. Dependencies can be specified by name or full/partial ID.
 	// Optional.
 	DependencyContainers []string `json:"dependencyContainers,omitempty"`
+	// HealthyDependencyContainers is an array of containers this container
+	// depends on which must report a healthy status, not merely be
+	// running, before this container is started. They are implicitly
+	// added to DependencyContainers and must have a healthcheck.
+	// Optional.
+	HealthyDependencyContainers []string `json:"healthyDependencyContainers,omitempty"`
+	// HealthyDependencyTimeout is the maximum time to wait for the
+	// HealthyDependencyContainers to become healthy.
+	// Optional.
+	HealthyDependencyTimeout time.Duration `json:"healthyDependencyTimeout,omitempty"`
 	// PidFile is the file that saves container's PID.
 	// Not supported for remote clients, so not serialized in specgen JSON.
 	// Optional.
@@ -551,6 +562,12 @@ type ContainerNetworkConfig struct {
 	// NetworkOptions are additional options for each network
 	// Optional.
 	NetworkOptions map[string][]string `json:"network_options,omitempty"`
+	// EgressAllow is a list of destinations the container may send
+	// traffic to, in the form HOST[:PORT] where HOST is a host name, an IP
+	// address or a network in CIDR notation. All other traffic is dropped.
+	// Only available if NetNS is set to bridge or pasta.
+	// Optional.
+	EgressAllow []string `json:"egress_allow,omitempty"`
 }
 
 // ContainerResourceConfig contains information on container resource limits.
@@ -592,6 +609,11 @@ type ContainerResourceConfig struct {
 type ContainerHealthCheckConfig struct {
 	HealthConfig               *manifest.Schema2HealthConfig     `json:"healthconfig,omitempty"`
 	HealthCheckOnFailureAction define.HealthCheckOnFailureAction `json:"health_check_on_failure_action,omitempty"`
+	// HealthCheckOnFailureHook is the command or http(s) URL notified once
+	// the container turns unhealthy.
+	// Requires HealthCheckOnFailureAction to be notify.
+	// Optional.
+	HealthCheckOnFailureHook string `json:"health_check_on_failure_hook,omitempty"`
 	// Startup healthcheck for a container.
 	// Requires that HealthConfig be set.
 	// Optional.
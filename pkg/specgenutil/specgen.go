@@ -369,6 +369,7 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		return err
 	}
 	s.HealthCheckOnFailureAction = onFailureAction
+	s.HealthCheckOnFailureHook = c.HealthOnFailureHook
 
 	s.HealthLogDestination = c.HealthLogDestination
 
@@ -679,6 +680,19 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 	if len(s.DependencyContainers) == 0 || len(c.Requires) != 0 {
 		s.DependencyContainers = c.Requires
 	}
+	if len(s.HealthyDependencyContainers) == 0 || len(c.RequiresHealthy) != 0 {
+		s.HealthyDependencyContainers = c.RequiresHealthy
+	}
+	if c.RequiresHealthyTimeout != "" {
+		timeout, err := time.ParseDuration(c.RequiresHealthyTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid requires-healthy-timeout %q: %w", c.RequiresHealthyTimeout, err)
+		}
+		if timeout <= 0 {
+			return errors.New("requires-healthy-timeout must be greater than 0")
+		}
+		s.HealthyDependencyTimeout = timeout
+	}
 
 	// Only add ReadWrite tmpfs mounts iff the container is
 	// being run ReadOnly and ReadWriteTmpFS is not disabled,
@@ -937,6 +951,9 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 	if s.Volatile == nil {
 		s.Volatile = &c.Rm
 	}
+	if len(s.EgressAllow) == 0 || len(c.EgressAllow) != 0 {
+		s.EgressAllow = c.EgressAllow
+	}
 	if len(s.EnvMerge) == 0 || len(c.EnvMerge) != 0 {
 		s.EnvMerge = c.EnvMerge
 	}
@@ -261,13 +261,10 @@ func parseAndValidatePort(port string) (uint16, error) {
 	return uint16(num), nil
 }
 
-func CreateExitCommandArgs(storageConfig storageTypes.StoreOptions, config *config.Config, syslog, rm, rmi, exec bool) ([]string, error) {
-	// We need a cleanup process for containers in the current model.
-	// But we can't assume that the caller is Podman - it could be another
-	// user of the API.
-	// As such, provide a way to specify a path to Podman, so we can
-	// still invoke a cleanup process.
-
+// CreatePodmanArgs returns the path to the Podman binary followed by the
+// global options required to run it against the same storage and
+// configuration as the current process.
+func CreatePodmanArgs(storageConfig storageTypes.StoreOptions, config *config.Config, syslog bool) ([]string, error) {
 	podmanPath, err := os.Executable()
 	if err != nil {
 		return nil, err
@@ -314,6 +311,20 @@ func CreateExitCommandArgs(storageConfig storageTypes.StoreOptions, config *conf
 		command = append(command, "--module", module)
 	}
 
+	return command, nil
+}
+
+func CreateExitCommandArgs(storageConfig storageTypes.StoreOptions, config *config.Config, syslog, rm, rmi, exec bool) ([]string, error) {
+	// We need a cleanup process for containers in the current model.
+	// But we can't assume that the caller is Podman - it could be another
+	// user of the API.
+	// As such, provide a way to specify a path to Podman, so we can
+	// still invoke a cleanup process.
+	command, err := CreatePodmanArgs(storageConfig, config, syslog)
+	if err != nil {
+		return nil, err
+	}
+
 	// --stopped-only is used to ensure we only cleanup stopped containers and do not race
 	// against other processes that did a cleanup() + init() again before we had the chance to run
 	command = append(command, []string{"container", "cleanup", "--stopped-only"}...)
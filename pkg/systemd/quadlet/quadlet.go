@@ -34,6 +34,7 @@ const (
 	NetworkGroup    = "Network"
 	PodGroup        = "Pod"
 	ServiceGroup    = "Service"
+	TimerGroup      = "Timer"
 	UnitGroup       = "Unit"
 	VolumeGroup     = "Volume"
 	ImageGroup      = "Image"
@@ -57,142 +58,147 @@ const (
 
 // All the supported quadlet keys
 const (
-	KeyAddCapability         = "AddCapability"
-	KeyAddDevice             = "AddDevice"
-	KeyAddHost               = "AddHost"
-	KeyAllTags               = "AllTags"
-	KeyAnnotation            = "Annotation"
-	KeyAppArmor              = "AppArmor"
-	KeyArch                  = "Arch"
-	KeyArtifact              = "Artifact"
-	KeyAuthFile              = "AuthFile"
-	KeyAutoUpdate            = "AutoUpdate"
-	KeyBuildArg              = "BuildArg"
-	KeyCertDir               = "CertDir"
-	KeyCgroupsMode           = "CgroupsMode"
-	KeyConfigMap             = "ConfigMap"
-	KeyContainerName         = "ContainerName"
-	KeyContainersConfModule  = "ContainersConfModule"
-	KeyCopy                  = "Copy"
-	KeyCreds                 = "Creds"
-	KeyDecryptionKey         = "DecryptionKey"
-	KeyDefaultDependencies   = "DefaultDependencies"
-	KeyDevice                = "Device"
-	KeyDisableDNS            = "DisableDNS"
-	KeyDNS                   = "DNS"
-	KeyDNSOption             = "DNSOption"
-	KeyDNSSearch             = "DNSSearch"
-	KeyDriver                = "Driver"
-	KeyDropCapability        = "DropCapability"
-	KeyEntrypoint            = "Entrypoint"
-	KeyEnvironment           = "Environment"
-	KeyEnvironmentFile       = "EnvironmentFile"
-	KeyEnvironmentHost       = "EnvironmentHost"
-	KeyExec                  = "Exec"
-	KeyExitCodePropagation   = "ExitCodePropagation"
-	KeyExitPolicy            = "ExitPolicy"
-	KeyExposeHostPort        = "ExposeHostPort"
-	KeyFile                  = "File"
-	KeyForceRM               = "ForceRM"
-	KeyGateway               = "Gateway"
-	KeyGIDMap                = "GIDMap"
-	KeyGlobalArgs            = "GlobalArgs"
-	KeyGroup                 = "Group"
-	KeyGroupAdd              = "GroupAdd"
-	KeyHealthCmd             = "HealthCmd"
-	KeyHealthInterval        = "HealthInterval"
-	KeyHealthLogDestination  = "HealthLogDestination"
-	KeyHealthMaxLogCount     = "HealthMaxLogCount"
-	KeyHealthMaxLogSize      = "HealthMaxLogSize"
-	KeyHealthOnFailure       = "HealthOnFailure"
-	KeyHealthRetries         = "HealthRetries"
-	KeyHealthStartPeriod     = "HealthStartPeriod"
-	KeyHealthStartupCmd      = "HealthStartupCmd"
-	KeyHealthStartupInterval = "HealthStartupInterval"
-	KeyHealthStartupRetries  = "HealthStartupRetries"
-	KeyHealthStartupSuccess  = "HealthStartupSuccess"
-	KeyHealthStartupTimeout  = "HealthStartupTimeout"
-	KeyHealthTimeout         = "HealthTimeout"
-	KeyHostName              = "HostName"
-	KeyHttpProxy             = "HttpProxy"
-	KeyIgnoreFile            = "IgnoreFile"
-	KeyImage                 = "Image"
-	KeyImageTag              = "ImageTag"
-	KeyInterfaceName         = "InterfaceName"
-	KeyInternal              = "Internal"
-	KeyIP                    = "IP"
-	KeyIP6                   = "IP6"
-	KeyIPAMDriver            = "IPAMDriver"
-	KeyIPRange               = "IPRange"
-	KeyIPv6                  = "IPv6"
-	KeyKubeDownForce         = "KubeDownForce"
-	KeyLabel                 = "Label"
-	KeyLogDriver             = "LogDriver"
-	KeyLogOpt                = "LogOpt"
-	KeyMask                  = "Mask"
-	KeyMemory                = "Memory"
-	KeyMount                 = "Mount"
-	KeyNetwork               = "Network"
-	KeyNetworkAlias          = "NetworkAlias"
-	KeyNetworkDeleteOnStop   = "NetworkDeleteOnStop"
-	KeyNetworkName           = "NetworkName"
-	KeyNoNewPrivileges       = "NoNewPrivileges"
-	KeyNotify                = "Notify"
-	KeyOptions               = "Options"
-	KeyOS                    = "OS"
-	KeyPidsLimit             = "PidsLimit"
-	KeyPod                   = "Pod"
-	KeyPodmanArgs            = "PodmanArgs"
-	KeyPodName               = "PodName"
-	KeyPolicy                = "Policy"
-	KeyPublishPort           = "PublishPort"
-	KeyPull                  = "Pull"
-	KeyQuiet                 = "Quiet"
-	KeyReadOnly              = "ReadOnly"
-	KeyReadOnlyTmpfs         = "ReadOnlyTmpfs"
-	KeyReloadCmd             = "ReloadCmd"
-	KeyReloadSignal          = "ReloadSignal"
-	KeyRemapGid              = "RemapGid"     // deprecated
-	KeyRemapUid              = "RemapUid"     // deprecated
-	KeyRemapUidSize          = "RemapUidSize" // deprecated
-	KeyRemapUsers            = "RemapUsers"   // deprecated
-	KeyRetry                 = "Retry"
-	KeyRetryDelay            = "RetryDelay"
-	KeyRootfs                = "Rootfs"
-	KeyRunInit               = "RunInit"
-	KeySeccompProfile        = "SeccompProfile"
-	KeySecret                = "Secret"
-	KeySecurityLabelDisable  = "SecurityLabelDisable"
-	KeySecurityLabelFileType = "SecurityLabelFileType"
-	KeySecurityLabelLevel    = "SecurityLabelLevel"
-	KeySecurityLabelNested   = "SecurityLabelNested"
-	KeySecurityLabelType     = "SecurityLabelType"
-	KeyServiceName           = "ServiceName"
-	KeySetWorkingDirectory   = "SetWorkingDirectory"
-	KeyShmSize               = "ShmSize"
-	KeyStartWithPod          = "StartWithPod"
-	KeyStopSignal            = "StopSignal"
-	KeyStopTimeout           = "StopTimeout"
-	KeySubGIDMap             = "SubGIDMap"
-	KeySubnet                = "Subnet"
-	KeySubUIDMap             = "SubUIDMap"
-	KeySysctl                = "Sysctl"
-	KeyTarget                = "Target"
-	KeyTimezone              = "Timezone"
-	KeyTLSVerify             = "TLSVerify"
-	KeyTmpfs                 = "Tmpfs"
-	KeyType                  = "Type"
-	KeyUIDMap                = "UIDMap"
-	KeyUlimit                = "Ulimit"
-	KeyUnmask                = "Unmask"
-	KeyUser                  = "User"
-	KeyUserNS                = "UserNS"
-	KeyVariant               = "Variant"
-	KeyVolatileTmp           = "VolatileTmp" // deprecated
-	KeyVolume                = "Volume"
-	KeyVolumeName            = "VolumeName"
-	KeyWorkingDir            = "WorkingDir"
-	KeyYaml                  = "Yaml"
+	KeyAddCapability          = "AddCapability"
+	KeyAddDevice              = "AddDevice"
+	KeyAddHost                = "AddHost"
+	KeyAllTags                = "AllTags"
+	KeyAnnotation             = "Annotation"
+	KeyAppArmor               = "AppArmor"
+	KeyArch                   = "Arch"
+	KeyArtifact               = "Artifact"
+	KeyAuthFile               = "AuthFile"
+	KeyAutoUpdate             = "AutoUpdate"
+	KeyBuildArg               = "BuildArg"
+	KeyCertDir                = "CertDir"
+	KeyCgroupsMode            = "CgroupsMode"
+	KeyConfigMap              = "ConfigMap"
+	KeyContainerName          = "ContainerName"
+	KeyContainersConfModule   = "ContainersConfModule"
+	KeyCopy                   = "Copy"
+	KeyCreds                  = "Creds"
+	KeyDecryptionKey          = "DecryptionKey"
+	KeyDefaultDependencies    = "DefaultDependencies"
+	KeyDevice                 = "Device"
+	KeyDisableDNS             = "DisableDNS"
+	KeyDNS                    = "DNS"
+	KeyDNSOption              = "DNSOption"
+	KeyDNSSearch              = "DNSSearch"
+	KeyDriver                 = "Driver"
+	KeyDropCapability         = "DropCapability"
+	KeyEgressAllow            = "EgressAllow"
+	KeyEntrypoint             = "Entrypoint"
+	KeyEnvironment            = "Environment"
+	KeyEnvironmentFile        = "EnvironmentFile"
+	KeyEnvironmentHost        = "EnvironmentHost"
+	KeyExec                   = "Exec"
+	KeyExitCodePropagation    = "ExitCodePropagation"
+	KeyExitPolicy             = "ExitPolicy"
+	KeyExposeHostPort         = "ExposeHostPort"
+	KeyFile                   = "File"
+	KeyForceRM                = "ForceRM"
+	KeyGateway                = "Gateway"
+	KeyGIDMap                 = "GIDMap"
+	KeyGlobalArgs             = "GlobalArgs"
+	KeyGroup                  = "Group"
+	KeyGroupAdd               = "GroupAdd"
+	KeyHealthCmd              = "HealthCmd"
+	KeyHealthInterval         = "HealthInterval"
+	KeyHealthLogDestination   = "HealthLogDestination"
+	KeyHealthMaxLogCount      = "HealthMaxLogCount"
+	KeyHealthMaxLogSize       = "HealthMaxLogSize"
+	KeyHealthOnFailure        = "HealthOnFailure"
+	KeyHealthOnFailureHook    = "HealthOnFailureHook"
+	KeyHealthRetries          = "HealthRetries"
+	KeyHealthStartPeriod      = "HealthStartPeriod"
+	KeyHealthStartupCmd       = "HealthStartupCmd"
+	KeyHealthStartupInterval  = "HealthStartupInterval"
+	KeyHealthStartupRetries   = "HealthStartupRetries"
+	KeyHealthStartupSuccess   = "HealthStartupSuccess"
+	KeyHealthStartupTimeout   = "HealthStartupTimeout"
+	KeyHealthTimeout          = "HealthTimeout"
+	KeyHostName               = "HostName"
+	KeyHttpProxy              = "HttpProxy"
+	KeyIgnoreFile             = "IgnoreFile"
+	KeyImage                  = "Image"
+	KeyImageTag               = "ImageTag"
+	KeyInterfaceName          = "InterfaceName"
+	KeyInternal               = "Internal"
+	KeyIP                     = "IP"
+	KeyIP6                    = "IP6"
+	KeyIPAMDriver             = "IPAMDriver"
+	KeyIPRange                = "IPRange"
+	KeyIPv6                   = "IPv6"
+	KeyKubeDownForce          = "KubeDownForce"
+	KeyLabel                  = "Label"
+	KeyLogDriver              = "LogDriver"
+	KeyLogOpt                 = "LogOpt"
+	KeyMask                   = "Mask"
+	KeyMemory                 = "Memory"
+	KeyMount                  = "Mount"
+	KeyNetwork                = "Network"
+	KeyNetworkAlias           = "NetworkAlias"
+	KeyNetworkDeleteOnStop    = "NetworkDeleteOnStop"
+	KeyNetworkName            = "NetworkName"
+	KeyNoNewPrivileges        = "NoNewPrivileges"
+	KeyNotify                 = "Notify"
+	KeyOptions                = "Options"
+	KeyOS                     = "OS"
+	KeyPidsLimit              = "PidsLimit"
+	KeyPod                    = "Pod"
+	KeyPodmanArgs             = "PodmanArgs"
+	KeyPodName                = "PodName"
+	KeyPolicy                 = "Policy"
+	KeyPublishPort            = "PublishPort"
+	KeyPull                   = "Pull"
+	KeyQuiet                  = "Quiet"
+	KeyReadOnly               = "ReadOnly"
+	KeyReadOnlyTmpfs          = "ReadOnlyTmpfs"
+	KeyReloadCmd              = "ReloadCmd"
+	KeyReloadSignal           = "ReloadSignal"
+	KeyRemapGid               = "RemapGid"     // deprecated
+	KeyRemapUid               = "RemapUid"     // deprecated
+	KeyRemapUidSize           = "RemapUidSize" // deprecated
+	KeyRemapUsers             = "RemapUsers"   // deprecated
+	KeyRequiresHealthy        = "RequiresHealthy"
+	KeyRequiresHealthyTimeout = "RequiresHealthyTimeout"
+	KeyRetry                  = "Retry"
+	KeyRetryDelay             = "RetryDelay"
+	KeyRootfs                 = "Rootfs"
+	KeyRunInit                = "RunInit"
+	KeySchedule               = "Schedule"
+	KeySeccompProfile         = "SeccompProfile"
+	KeySecret                 = "Secret"
+	KeySecurityLabelDisable   = "SecurityLabelDisable"
+	KeySecurityLabelFileType  = "SecurityLabelFileType"
+	KeySecurityLabelLevel     = "SecurityLabelLevel"
+	KeySecurityLabelNested    = "SecurityLabelNested"
+	KeySecurityLabelType      = "SecurityLabelType"
+	KeyServiceName            = "ServiceName"
+	KeySetWorkingDirectory    = "SetWorkingDirectory"
+	KeyShmSize                = "ShmSize"
+	KeyStartWithPod           = "StartWithPod"
+	KeyStopSignal             = "StopSignal"
+	KeyStopTimeout            = "StopTimeout"
+	KeySubGIDMap              = "SubGIDMap"
+	KeySubnet                 = "Subnet"
+	KeySubUIDMap              = "SubUIDMap"
+	KeySysctl                 = "Sysctl"
+	KeyTarget                 = "Target"
+	KeyTimezone               = "Timezone"
+	KeyTLSVerify              = "TLSVerify"
+	KeyTmpfs                  = "Tmpfs"
+	KeyType                   = "Type"
+	KeyUIDMap                 = "UIDMap"
+	KeyUlimit                 = "Ulimit"
+	KeyUnmask                 = "Unmask"
+	KeyUser                   = "User"
+	KeyUserNS                 = "UserNS"
+	KeyVariant                = "Variant"
+	KeyVolatileTmp            = "VolatileTmp" // deprecated
+	KeyVolume                 = "Volume"
+	KeyVolumeName             = "VolumeName"
+	KeyWorkingDir             = "WorkingDir"
+	KeyYaml                   = "Yaml"
 )
 
 // Unsupported keys in the Service group. Defined here so we can error when they are found
@@ -245,100 +251,105 @@ var (
 			GroupName:  ContainerGroup,
 			XGroupName: XContainerGroup,
 			SupportedKeys: map[string]bool{
-				KeyAddCapability:         true,
-				KeyAddDevice:             true,
-				KeyAddHost:               true,
-				KeyAnnotation:            true,
-				KeyAppArmor:              true,
-				KeyAutoUpdate:            true,
-				KeyCgroupsMode:           true,
-				KeyContainerName:         true,
-				KeyContainersConfModule:  true,
-				KeyDNS:                   true,
-				KeyDNSOption:             true,
-				KeyDNSSearch:             true,
-				KeyDropCapability:        true,
-				KeyEnvironment:           true,
-				KeyEnvironmentFile:       true,
-				KeyEnvironmentHost:       true,
-				KeyEntrypoint:            true,
-				KeyExec:                  true,
-				KeyExposeHostPort:        true,
-				KeyGIDMap:                true,
-				KeyGlobalArgs:            true,
-				KeyGroup:                 true,
-				KeyGroupAdd:              true,
-				KeyHealthCmd:             true,
-				KeyHealthInterval:        true,
-				KeyHealthOnFailure:       true,
-				KeyHealthLogDestination:  true,
-				KeyHealthMaxLogCount:     true,
-				KeyHealthMaxLogSize:      true,
-				KeyHealthRetries:         true,
-				KeyHealthStartPeriod:     true,
-				KeyHealthStartupCmd:      true,
-				KeyHealthStartupInterval: true,
-				KeyHealthStartupRetries:  true,
-				KeyHealthStartupSuccess:  true,
-				KeyHealthStartupTimeout:  true,
-				KeyHealthTimeout:         true,
-				KeyHostName:              true,
-				KeyHttpProxy:             true,
-				KeyIP6:                   true,
-				KeyIP:                    true,
-				KeyImage:                 true,
-				KeyLabel:                 true,
-				KeyLogDriver:             true,
-				KeyLogOpt:                true,
-				KeyMask:                  true,
-				KeyMemory:                true,
-				KeyMount:                 true,
-				KeyNetwork:               true,
-				KeyNetworkAlias:          true,
-				KeyNoNewPrivileges:       true,
-				KeyNotify:                true,
-				KeyPidsLimit:             true,
-				KeyPod:                   true,
-				KeyPodmanArgs:            true,
-				KeyPublishPort:           true,
-				KeyPull:                  true,
-				KeyReadOnly:              true,
-				KeyReadOnlyTmpfs:         true,
-				KeyReloadCmd:             true,
-				KeyReloadSignal:          true,
-				KeyRemapGid:              true,
-				KeyRemapUid:              true,
-				KeyRemapUidSize:          true,
-				KeyRemapUsers:            true,
-				KeyRetry:                 true,
-				KeyRetryDelay:            true,
-				KeyRootfs:                true,
-				KeyRunInit:               true,
-				KeySeccompProfile:        true,
-				KeySecret:                true,
-				KeySecurityLabelDisable:  true,
-				KeySecurityLabelFileType: true,
-				KeySecurityLabelLevel:    true,
-				KeySecurityLabelNested:   true,
-				KeySecurityLabelType:     true,
-				KeyServiceName:           true,
-				KeyShmSize:               true,
-				KeyStopSignal:            true,
-				KeyStartWithPod:          true,
-				KeyStopTimeout:           true,
-				KeySubGIDMap:             true,
-				KeySubUIDMap:             true,
-				KeySysctl:                true,
-				KeyTimezone:              true,
-				KeyTmpfs:                 true,
-				KeyUIDMap:                true,
-				KeyUlimit:                true,
-				KeyUnmask:                true,
-				KeyUser:                  true,
-				KeyUserNS:                true,
-				KeyVolatileTmp:           true,
-				KeyVolume:                true,
-				KeyWorkingDir:            true,
+				KeyAddCapability:          true,
+				KeyAddDevice:              true,
+				KeyAddHost:                true,
+				KeyAnnotation:             true,
+				KeyAppArmor:               true,
+				KeyAutoUpdate:             true,
+				KeyCgroupsMode:            true,
+				KeyContainerName:          true,
+				KeyContainersConfModule:   true,
+				KeyDNS:                    true,
+				KeyDNSOption:              true,
+				KeyDNSSearch:              true,
+				KeyDropCapability:         true,
+				KeyEgressAllow:            true,
+				KeyEnvironment:            true,
+				KeyEnvironmentFile:        true,
+				KeyEnvironmentHost:        true,
+				KeyEntrypoint:             true,
+				KeyExec:                   true,
+				KeyExposeHostPort:         true,
+				KeyGIDMap:                 true,
+				KeyGlobalArgs:             true,
+				KeyGroup:                  true,
+				KeyGroupAdd:               true,
+				KeyHealthCmd:              true,
+				KeyHealthInterval:         true,
+				KeyHealthOnFailure:        true,
+				KeyHealthOnFailureHook:    true,
+				KeyHealthLogDestination:   true,
+				KeyHealthMaxLogCount:      true,
+				KeyHealthMaxLogSize:       true,
+				KeyHealthRetries:          true,
+				KeyHealthStartPeriod:      true,
+				KeyHealthStartupCmd:       true,
+				KeyHealthStartupInterval:  true,
+				KeyHealthStartupRetries:   true,
+				KeyHealthStartupSuccess:   true,
+				KeyHealthStartupTimeout:   true,
+				KeyHealthTimeout:          true,
+				KeyHostName:               true,
+				KeyHttpProxy:              true,
+				KeyIP6:                    true,
+				KeyIP:                     true,
+				KeyImage:                  true,
+				KeyLabel:                  true,
+				KeyLogDriver:              true,
+				KeyLogOpt:                 true,
+				KeyMask:                   true,
+				KeyMemory:                 true,
+				KeyMount:                  true,
+				KeyNetwork:                true,
+				KeyNetworkAlias:           true,
+				KeyNoNewPrivileges:        true,
+				KeyNotify:                 true,
+				KeyPidsLimit:              true,
+				KeyPod:                    true,
+				KeyPodmanArgs:             true,
+				KeyPublishPort:            true,
+				KeyPull:                   true,
+				KeyReadOnly:               true,
+				KeyReadOnlyTmpfs:          true,
+				KeyReloadCmd:              true,
+				KeyReloadSignal:           true,
+				KeyRemapGid:               true,
+				KeyRemapUid:               true,
+				KeyRemapUidSize:           true,
+				KeyRemapUsers:             true,
+				KeyRequiresHealthy:        true,
+				KeyRequiresHealthyTimeout: true,
+				KeyRetry:                  true,
+				KeyRetryDelay:             true,
+				KeyRootfs:                 true,
+				KeyRunInit:                true,
+				KeySchedule:               true,
+				KeySeccompProfile:         true,
+				KeySecret:                 true,
+				KeySecurityLabelDisable:   true,
+				KeySecurityLabelFileType:  true,
+				KeySecurityLabelLevel:     true,
+				KeySecurityLabelNested:    true,
+				KeySecurityLabelType:      true,
+				KeyServiceName:            true,
+				KeyShmSize:                true,
+				KeyStopSignal:             true,
+				KeyStartWithPod:           true,
+				KeyStopTimeout:            true,
+				KeySubGIDMap:              true,
+				KeySubUIDMap:              true,
+				KeySysctl:                 true,
+				KeyTimezone:               true,
+				KeyTmpfs:                  true,
+				KeyUIDMap:                 true,
+				KeyUlimit:                 true,
+				KeyUnmask:                 true,
+				KeyUser:                   true,
+				KeyUserNS:                 true,
+				KeyVolatileTmp:            true,
+				KeyVolume:                 true,
+				KeyWorkingDir:             true,
 			},
 		},
 		VolumeGroup: {
@@ -676,20 +687,21 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 	}
 
 	stringKeys := map[string]string{
-		KeyTimezone:    "--tz",
-		KeyPidsLimit:   "--pids-limit",
-		KeyShmSize:     "--shm-size",
-		KeyEntrypoint:  "--entrypoint",
-		KeyWorkingDir:  "--workdir",
-		KeyIP:          "--ip",
-		KeyIP6:         "--ip6",
-		KeyHostName:    "--hostname",
-		KeyStopSignal:  "--stop-signal",
-		KeyStopTimeout: "--stop-timeout",
-		KeyPull:        "--pull",
-		KeyMemory:      "--memory",
-		KeyRetry:       "--retry",
-		KeyRetryDelay:  "--retry-delay",
+		KeyTimezone:               "--tz",
+		KeyPidsLimit:              "--pids-limit",
+		KeyShmSize:                "--shm-size",
+		KeyEntrypoint:             "--entrypoint",
+		KeyWorkingDir:             "--workdir",
+		KeyIP:                     "--ip",
+		KeyIP6:                    "--ip6",
+		KeyHostName:               "--hostname",
+		KeyStopSignal:             "--stop-signal",
+		KeyStopTimeout:            "--stop-timeout",
+		KeyPull:                   "--pull",
+		KeyMemory:                 "--memory",
+		KeyRetry:                  "--retry",
+		KeyRetryDelay:             "--retry-delay",
+		KeyRequiresHealthyTimeout: "--requires-healthy-timeout",
 	}
 	lookupAndAddString(container, ContainerGroup, stringKeys, podman)
 
@@ -702,6 +714,7 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 		KeyGroupAdd:     "--group-add",
 		KeyAddHost:      "--add-host",
 		KeyTmpfs:        "--tmpfs",
+		KeyEgressAllow:  "--egress-allow",
 	}
 	lookupAndAddAllStrings(container, ContainerGroup, allStringsKeys, podman)
 
@@ -717,6 +730,19 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 		return nil, warnings, err
 	}
 
+	if err := addRequiresHealthy(container, ContainerGroup, service, unitsInfoMap, podman); err != nil {
+		return nil, warnings, err
+	}
+
+	if container.HasKey(ContainerGroup, KeySchedule) {
+		// A scheduled container runs to completion every time its timer
+		// elapses, RemainAfterExit is left unset so that the timer can
+		// start it again. The timer is enabled instead of the service.
+		defaultOneshotServiceGroup(service, false)
+		service.RemoveGroup(TimerGroup)
+		service.RemoveGroup(InstallGroup)
+	}
+
 	serviceType, ok := service.Lookup(ServiceGroup, "Type")
 	if ok && serviceType != "notify" && serviceType != "oneshot" {
 		return nil, warnings, fmt.Errorf("invalid service Type '%s'", serviceType)
@@ -929,6 +955,43 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 	return service, warnings, nil
 }
 
+// ConvertContainerTimer returns the timer unit starting the service of a
+// container on the calendar events set with the Schedule key, or nil if the
+// container is not scheduled. The Timer and Install groups of the container
+// are used for the timer.
+func ConvertContainerTimer(container, service *parser.UnitFile) (*parser.UnitFile, error) {
+	schedules := container.LookupAll(ContainerGroup, KeySchedule)
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+
+	timer := parser.NewUnitFile()
+	timer.Filename = strings.TrimSuffix(service.Filename, ".service") + ".timer"
+	if description, ok := container.Lookup(UnitGroup, "Description"); ok {
+		timer.Set(UnitGroup, "Description", description)
+	}
+	if container.Path != "" {
+		timer.Add(UnitGroup, "SourcePath", container.Path)
+	}
+
+	for _, schedule := range schedules {
+		if schedule == "" {
+			return nil, fmt.Errorf("empty %s key", KeySchedule)
+		}
+		timer.Add(TimerGroup, "OnCalendar", schedule)
+	}
+
+	groups := container.Dup()
+	for _, group := range groups.ListGroups() {
+		if group != TimerGroup && group != InstallGroup {
+			groups.RemoveGroup(group)
+		}
+	}
+	timer.Merge(groups)
+
+	return timer, nil
+}
+
 // Get the unresolved container name that may contain '%'.
 func getContainerName(container *parser.UnitFile) string {
 	containerName, ok := container.Lookup(ContainerGroup, KeyContainerName)
@@ -1862,6 +1925,34 @@ func addNetworks(quadletUnitFile *parser.UnitFile, groupName string, serviceUnit
 	return nil
 }
 
+func addRequiresHealthy(quadletUnitFile *parser.UnitFile, groupName string, serviceUnitFile *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, podman *PodmanCmdline) error {
+	requires := quadletUnitFile.LookupAllStrv(groupName, KeyRequiresHealthy)
+	for _, container := range requires {
+		if strings.HasSuffix(container, ".container") {
+			unitInfo, ok := unitsInfoMap[container]
+			if !ok {
+				return fmt.Errorf("requested Quadlet unit %s was not found", container)
+			}
+
+			// XXX: this is usually because a '@' in service name
+			if len(unitInfo.ResourceName) == 0 {
+				return fmt.Errorf("cannot get the resource name of %s", container)
+			}
+
+			// the systemd unit name is $serviceName.service
+			serviceFileName := unitInfo.ServiceFileName()
+
+			serviceUnitFile.Add(UnitGroup, "Requires", serviceFileName)
+			serviceUnitFile.Add(UnitGroup, "After", serviceFileName)
+
+			container = unitInfo.ResourceName
+		}
+
+		podman.add("--requires-healthy", container)
+	}
+	return nil
+}
+
 // Systemd Specifiers start with % with the exception of %%
 func startsWithSystemdSpecifier(filePath string) bool {
 	if len(filePath) == 0 || filePath[0] != '%' {
@@ -1959,6 +2050,7 @@ func handleHealth(unitFile *parser.UnitFile, groupName string, podman *PodmanCmd
 		{KeyHealthCmd, "cmd"},
 		{KeyHealthInterval, "interval"},
 		{KeyHealthOnFailure, "on-failure"},
+		{KeyHealthOnFailureHook, "on-failure-hook"},
 		{KeyHealthLogDestination, "log-destination"},
 		{KeyHealthMaxLogCount, "max-log-count"},
 		{KeyHealthMaxLogSize, "max-log-size"},
@@ -212,6 +212,52 @@ var _ = Describe("Podman artifact", func() {
 		Expect(a.Name).To(Equal(artifact1Name))
 	})
 
+	It("podman artifact push and pull with signatures", func() {
+		SkipIfRemote("Remote does not support signing")
+		// See "podman push and pull signed images" for why /etc is modified directly.
+		systemRegistriesDAddition := "/etc/containers/registries.d/podman-test-only-temporary-addition.yaml"
+		registriesDFragment, err := os.ReadFile("testdata/sigstore-registries.d-fragment.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		if err := os.WriteFile(systemRegistriesDAddition, registriesDFragment, 0o644); err != nil {
+			Skip(fmt.Sprintf("/etc/containers/registries.d isn’t writable: %s", err))
+		}
+		defer os.Remove(systemRegistriesDAddition)
+
+		registryPort := 5003
+		lock, port, err := setupRegistry(&registryPort)
+		if err == nil {
+			defer lock.Unlock()
+		}
+		Expect(err).ToNot(HaveOccurred())
+
+		policyPath := generatePolicyFile(podmanTest.TempDir, registryPort, "testdata/sequoia-key.pub")
+		defer os.Remove(policyPath)
+
+		artifactFile, err := createArtifactFile(1024)
+		Expect(err).ToNot(HaveOccurred())
+		artifactName := fmt.Sprintf("localhost:%s/sigstore-signed:artifact", port)
+		podmanTest.PodmanExitCleanly("artifact", "add", artifactName, artifactFile)
+
+		// The policy rejects unsigned artifacts
+		podmanTest.PodmanExitCleanly("artifact", "push", "-q", "--tls-verify=false", artifactName)
+		podmanTest.PodmanExitCleanly("artifact", "rm", artifactName)
+		pull := podmanTest.Podman([]string{"artifact", "pull", "-q", "--tls-verify=false", "--signature-policy", policyPath, artifactName})
+		pull.WaitWithDefaultTimeout()
+		Expect(pull).To(ExitWithError(125, "artifact "+artifactName+" rejected by the signature policy: Source image rejected: A signature was required, but no signature exists"))
+		inspect := podmanTest.Podman([]string{"artifact", "inspect", artifactName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(ExitWithError(125, "artifact does not exist"))
+
+		// Signed artifacts are accepted
+		podmanTest.PodmanExitCleanly("artifact", "add", artifactName, artifactFile)
+		podmanTest.PodmanExitCleanly("artifact", "push", "-q", "--tls-verify=false", "--sign-by-sigstore-private-key", "testdata/sigstore-key.key", "--sign-passphrase-file", "testdata/sigstore-key.key.pass", artifactName)
+		podmanTest.PodmanExitCleanly("artifact", "rm", artifactName)
+		podmanTest.PodmanExitCleanly("artifact", "pull", "-q", "--tls-verify=false", "--signature-policy", policyPath, artifactName)
+
+		a := podmanTest.InspectArtifact(artifactName)
+		Expect(a.Name).To(Equal(artifactName))
+	})
+
 	It("podman artifact push with authorization", func() {
 		portNo, err := utils.GetRandomPort()
 		Expect(err).ToNot(HaveOccurred())
@@ -694,6 +740,88 @@ var _ = Describe("Podman artifact", func() {
 		Expect(failSession).Should(ExitWithError(125, "Error: append option is not compatible with type option"))
 	})
 
+	It("podman artifact add --replace-file", func() {
+		artifact1File, err := createArtifactFile(1024)
+		Expect(err).ToNot(HaveOccurred())
+		artifact2File, err := createArtifactFile(2048)
+		Expect(err).ToNot(HaveOccurred())
+		artifact3File, err := createArtifactFile(4192)
+		Expect(err).ToNot(HaveOccurred())
+
+		artifact1Name := "localhost/test/artifact1:latest"
+		podmanTest.PodmanExitCleanly("artifact", "add", "--annotation", "color=blue", artifact1Name, artifact1File, artifact2File)
+		before := podmanTest.InspectArtifact(artifact1Name)
+
+		err = os.WriteFile(artifact1File, []byte("replaced content"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+		podmanTest.PodmanExitCleanly("artifact", "add", "--replace-file", artifact1Name, artifact1File, artifact3File)
+
+		a := podmanTest.InspectArtifact(artifact1Name)
+		Expect(a.Manifest.Layers).To(HaveLen(3))
+		// The replaced file keeps its position, the other file its blob
+		Expect(a.Manifest.Layers[0].Annotations).To(HaveKeyWithValue(imgspec.AnnotationTitle, filepath.Base(artifact1File)))
+		Expect(a.Manifest.Layers[0].Digest).ToNot(Equal(before.Manifest.Layers[0].Digest))
+		Expect(a.Manifest.Layers[0].Size).To(Equal(int64(len("replaced content"))))
+		Expect(a.Manifest.Layers[1]).To(Equal(before.Manifest.Layers[1]))
+		Expect(a.Manifest.Layers[2].Annotations).To(HaveKeyWithValue(imgspec.AnnotationTitle, filepath.Base(artifact3File)))
+
+		// The reused blob is still intact
+		extractPath := filepath.Join(podmanTest.TempDir, "extracted")
+		podmanTest.PodmanExitCleanly("artifact", "extract", "--title", filepath.Base(artifact2File), artifact1Name, extractPath)
+		Expect(readFileToString(extractPath)).To(Equal(readFileToString(artifact2File)))
+
+		// A failed replace leaves the artifact unchanged
+		missingFile := filepath.Join(podmanTest.TempDir, "missing")
+		failSession := podmanTest.Podman([]string{"artifact", "add", "--replace-file", artifact1Name, artifact2File, missingFile})
+		failSession.WaitWithDefaultTimeout()
+		Expect(failSession).Should(ExitWithError(125, "no such file or directory"))
+		Expect(podmanTest.InspectArtifact(artifact1Name).Manifest).To(Equal(a.Manifest))
+
+		failSession = podmanTest.Podman([]string{"artifact", "add", "--replace-file", "--replace", artifact1Name, artifact2File})
+		failSession.WaitWithDefaultTimeout()
+		Expect(failSession).Should(ExitWithError(125, "--replace-file cannot be used with the --append or --replace options"))
+
+		// --replace still replaces the whole artifact
+		podmanTest.PodmanExitCleanly("artifact", "add", "--replace", artifact1Name, artifact3File)
+		a = podmanTest.InspectArtifact(artifact1Name)
+		Expect(a.Manifest.Layers).To(HaveLen(1))
+		Expect(a.Manifest.Layers[0].Annotations).To(HaveKeyWithValue(imgspec.AnnotationTitle, filepath.Base(artifact3File)))
+	})
+
+	It("podman artifact diff", func() {
+		artifact1File, err := createArtifactFile(1024)
+		Expect(err).ToNot(HaveOccurred())
+		artifact2File, err := createArtifactFile(2048)
+		Expect(err).ToNot(HaveOccurred())
+		artifact3File, err := createArtifactFile(4192)
+		Expect(err).ToNot(HaveOccurred())
+
+		artifactV1 := "localhost/test/artifact1:v1"
+		artifactV2 := "localhost/test/artifact1:v2"
+		podmanTest.PodmanExitCleanly("artifact", "add", artifactV1, artifact1File, artifact2File)
+		err = os.WriteFile(artifact1File, []byte("new content"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+		podmanTest.PodmanExitCleanly("artifact", "add", artifactV2, artifact1File, artifact3File)
+
+		diff := podmanTest.PodmanExitCleanly("artifact", "diff", artifactV1, artifactV2)
+		Expect(diff.OutputToStringArray()).To(ConsistOf(
+			"C "+filepath.Base(artifact1File),
+			"D "+filepath.Base(artifact2File),
+			"A "+filepath.Base(artifact3File),
+		))
+
+		diff = podmanTest.PodmanExitCleanly("artifact", "diff", "--format", "json", artifactV1, artifactV2)
+		Expect(diff.OutputToString()).To(BeValidJSON())
+		Expect(diff.OutputToString()).To(ContainSubstring(`"Kind": "changed"`))
+
+		diff = podmanTest.PodmanExitCleanly("artifact", "diff", artifactV1, artifactV1)
+		Expect(diff.OutputToString()).To(BeEmpty())
+
+		diff = podmanTest.Podman([]string{"artifact", "diff", "--format", "table", artifactV1, artifactV2})
+		diff.WaitWithDefaultTimeout()
+		Expect(diff).Should(ExitWithError(125, "only supported value for '--format' is 'json'"))
+	})
+
 	It("podman artifact inspect shows created date", func() {
 		artifact1File, err := createArtifactFile(1024)
 		Expect(err).ToNot(HaveOccurred())
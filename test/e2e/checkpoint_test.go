@@ -1109,6 +1109,25 @@ var _ = Describe("Podman checkpoint", func() {
 		os.Remove(preCheckpointFileName)
 	})
 
+	It("podman container migrate to unreachable host keeps container running", func() {
+		SkipIfRemote("migrate is not supported on the remote client")
+		localRunString := getRunString([]string{ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		result := podmanTest.Podman([]string{"container", "migrate", "--pre-dumps", "0", cid, "root@unreachable.invalid"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitWithError(125, "creating temporary directory on root@unreachable.invalid"))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+		Expect(podmanTest.GetContainerStatus()).To(ContainSubstring("Up"))
+
+		result = podmanTest.Podman([]string{"container", "migrate", "--pre-dumps", "-1", cid, "root@unreachable.invalid"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitWithError(125, "invalid number of pre-dumps -1"))
+	})
+
 	It("podman checkpoint and restore container with different port mappings", func() {
 		Skip("FIXME: #26289 - Rawhide only issue, skip for now")
 		randomPort, err := utils.GetRandomPort()
@@ -1328,6 +1347,129 @@ var _ = Describe("Podman checkpoint", func() {
 		})
 	}
 
+	It("podman pod checkpoint and restore", func() {
+		SkipIfRemote("podman pod checkpoint is not supported on the remote client")
+		if err := criu.CheckForCriu(criu.PodCriuVersion); err != nil {
+			Skip(fmt.Sprintf("check CRIU pod version error: %v", err))
+		}
+		if !crutils.CRRuntimeSupportsPodCheckpointRestore(podmanTest.OCIRuntime) {
+			Skip("runtime does not support pod restore: " + podmanTest.OCIRuntime)
+		}
+
+		session := podmanTest.Podman([]string{"pod", "create", "--name", "test_pod", "--network", netname})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitCleanly())
+		podID := session.OutputToString()
+
+		for _, name := range []string{"test_pod-top", "other"} {
+			session = podmanTest.Podman([]string{"run", "-d", "--name", name, "--pod", podID, ALPINE, "top"})
+			session.WaitWithDefaultTimeout()
+			Expect(session).To(ExitCleanly())
+		}
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(3))
+
+		fileName := filepath.Join(podmanTest.TempDir, "pod-checkpoint.tar.zst")
+		result := podmanTest.Podman([]string{"pod", "checkpoint", "-e", fileName, "test_pod"})
+		result.WaitWithDefaultTimeout()
+		// #11784 (closed wontfix): runc warns "lstat /sys/.../machine.slice/...: ENOENT"
+		// so we can't use ExitCleanly()
+		if podmanTest.OCIRuntime == "runc" {
+			Expect(result).To(Exit(0))
+		} else {
+			Expect(result).To(ExitCleanly())
+		}
+		Expect(result.OutputToString()).To(Equal(podID))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
+
+		// The pod still exists, so it cannot be restored under its name.
+		result = podmanTest.Podman([]string{"pod", "restore", "-i", fileName})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitWithError(125, "already exists"))
+
+		result = podmanTest.Podman([]string{"pod", "rm", "-f", podID})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(Exit(0))
+
+		result = podmanTest.Podman([]string{"pod", "restore", "-i", fileName})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitCleanly())
+		Expect(result.OutputToString()).To(Equal(podID))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(3))
+
+		// Restore a copy of the pod under a new name.
+		result = podmanTest.Podman([]string{"pod", "restore", "-i", fileName, "--name", "test_pod_copy", "--ignore-volumes"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitCleanly())
+		copyID := result.OutputToString()
+		Expect(copyID).ToNot(Equal(podID))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(6))
+
+		for _, name := range []string{"test_pod_copy-top", "test_pod_copy-other"} {
+			result = podmanTest.Podman([]string{"container", "inspect", "--format", "{{.Pod}} {{.State.Status}}", name})
+			result.WaitWithDefaultTimeout()
+			Expect(result).To(ExitCleanly())
+			Expect(result.OutputToString()).To(Equal(copyID + " running"))
+		}
+
+		result = podmanTest.Podman([]string{"pod", "rm", "-fa"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(Exit(0))
+	})
+
+	It("podman pod checkpoint with a paused container", func() {
+		SkipIfRemote("podman pod checkpoint is not supported on the remote client")
+		if err := criu.CheckForCriu(criu.PodCriuVersion); err != nil {
+			Skip(fmt.Sprintf("check CRIU pod version error: %v", err))
+		}
+		if !crutils.CRRuntimeSupportsPodCheckpointRestore(podmanTest.OCIRuntime) {
+			Skip("runtime does not support pod restore: " + podmanTest.OCIRuntime)
+		}
+
+		session := podmanTest.Podman([]string{"pod", "create", "--name", "test_pod", "--network", netname})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitCleanly())
+		podID := session.OutputToString()
+
+		for _, name := range []string{"test_pod-top", "test_pod-paused"} {
+			session = podmanTest.Podman([]string{"run", "-d", "--name", name, "--pod", podID, ALPINE, "top"})
+			session.WaitWithDefaultTimeout()
+			Expect(session).To(ExitCleanly())
+		}
+		podmanTest.PodmanExitCleanly("pause", "test_pod-paused")
+
+		checkpoint := func(args ...string) {
+			fileName := filepath.Join(podmanTest.TempDir, "pod-checkpoint.tar")
+			result := podmanTest.Podman(append([]string{"pod", "checkpoint", "-e", fileName}, append(args, "test_pod")...))
+			result.WaitWithDefaultTimeout()
+			// #11784 (closed wontfix): runc warns "lstat /sys/.../machine.slice/...: ENOENT"
+			// so we can't use ExitCleanly()
+			if podmanTest.OCIRuntime == "runc" {
+				Expect(result).To(Exit(0))
+			} else {
+				Expect(result).To(ExitCleanly())
+			}
+			Expect(result.OutputToString()).To(Equal(podID))
+			os.Remove(fileName)
+		}
+		status := func(name string) string {
+			result := podmanTest.PodmanExitCleanly("container", "inspect", "--format", "{{.State.Status}}", name)
+			return result.OutputToString()
+		}
+
+		// Containers paused before are left paused.
+		checkpoint("--leave-running")
+		Expect(status("test_pod-top")).To(Equal("running"))
+		Expect(status("test_pod-paused")).To(Equal("paused"))
+
+		// The paused container is killed rather than left frozen.
+		checkpoint()
+		Expect(status("test_pod-top")).To(Equal("exited"))
+		Expect(status("test_pod-paused")).To(Equal("exited"))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
+
+		podmanTest.PodmanExitCleanly("pod", "rm", "-f", podID)
+	})
+
 	It("podman checkpoint container with export (migration) and --ipc host", func() {
 		localRunString := getRunString([]string{"--rm", "--ipc", "host", ALPINE, "top"})
 		session := podmanTest.Podman(localRunString)
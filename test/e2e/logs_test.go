@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 	"time"
 
 	. "github.com/containers/podman/v6/test/utils"
@@ -443,6 +444,47 @@ var _ = Describe("Podman logs", func() {
 			}).WithTimeout(logTimeout).Should(Succeed())
 		})
 
+		It("podman logs with grep and filter: "+log, func() {
+			skipIfJournaldInContainer()
+
+			cname := "log-test"
+			script := `echo '{"level":"info","req":{"method":"GET"}}'; echo '{"level":"error","status":500}'; echo plain error >&2; echo plain info`
+			logc := podmanTest.Podman([]string{"run", "--log-driver", log, "--name", cname, ALPINE, "sh", "-c", script})
+			logc.WaitWithDefaultTimeout()
+			Expect(logc).To(Exit(0))
+
+			Eventually(func(g Gomega) {
+				results := podmanTest.Podman([]string{"logs", "--grep", "error", cname})
+				results.WaitWithDefaultTimeout()
+				g.Expect(results).To(Exit(0))
+				g.Expect(results.OutputToString()).To(Equal(`{"level":"error","status":500}`))
+				g.Expect(results.ErrorToString()).To(Equal("plain error"))
+			}).WithTimeout(logTimeout).Should(Succeed())
+
+			results := podmanTest.Podman([]string{"logs", "--filter", "stream=stdout", "--filter", "level=info", "--filter", "level=error", cname})
+			results.WaitWithDefaultTimeout()
+			Expect(results).To(ExitCleanly())
+			Expect(results.OutputToStringArray()).To(Equal([]string{`{"level":"info","req":{"method":"GET"}}`, `{"level":"error","status":500}`}))
+
+			results = podmanTest.Podman([]string{"logs", "--filter", "req.method=GET", cname})
+			results.WaitWithDefaultTimeout()
+			Expect(results).To(ExitCleanly())
+			Expect(results.OutputToString()).To(Equal(`{"level":"info","req":{"method":"GET"}}`))
+
+			results = podmanTest.Podman([]string{"logs", "--tail", "1", "--filter", "status=500", cname})
+			results.WaitWithDefaultTimeout()
+			Expect(results).To(ExitCleanly())
+			Expect(results.OutputToString()).To(Equal(`{"level":"error","status":500}`))
+
+			results = podmanTest.Podman([]string{"logs", "--filter", "level", cname})
+			results.WaitWithDefaultTimeout()
+			Expect(results).To(ExitWithError(125, `invalid filter "level", must be in the form field=value`))
+
+			results = podmanTest.Podman([]string{"logs", "--grep", "(", cname})
+			results.WaitWithDefaultTimeout()
+			Expect(results).To(ExitWithError(125, "error parsing regexp"))
+		})
+
 		It("podman logs partial log lines: "+log, func() {
 			skipIfJournaldInContainer()
 
@@ -575,6 +617,96 @@ var _ = Describe("Podman logs", func() {
 		}).Should(Succeed())
 	})
 
+	It("podman logs with rotated log files", func() {
+		ctrName := "logsctr"
+		logc := podmanTest.Podman([]string{"create", "--log-driver", "k8s-file", "--log-opt", "max-size=10", "--log-opt", "max-file=2", "--log-opt", "compress=true", "--name", ctrName, ALPINE, "echo", "podman"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(ExitCleanly())
+
+		// the log file is rotated before each start
+		for range 3 {
+			start := podmanTest.Podman([]string{"start", "--attach", ctrName})
+			start.WaitWithDefaultTimeout()
+			Expect(start).To(ExitCleanly())
+		}
+
+		inspect := podmanTest.Podman([]string{"container", "inspect", "--format", "{{.HostConfig.LogConfig.Path}}", ctrName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(ExitCleanly())
+		logPath := inspect.OutputToString()
+		Expect(logPath + ".1").To(BeARegularFile())
+		Expect(logPath + ".2.gz").To(BeARegularFile())
+
+		results := podmanTest.Podman([]string{"logs", ctrName})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(ExitCleanly())
+		Expect(results.OutputToStringArray()).To(Equal([]string{"podman", "podman", "podman"}))
+
+		results = podmanTest.Podman([]string{"logs", "--tail", "2", ctrName})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(ExitCleanly())
+		Expect(results.OutputToStringArray()).To(Equal([]string{"podman", "podman"}))
+
+		if !IsRemote() {
+			rotate := podmanTest.Podman([]string{"container", "rotate-logs", ctrName})
+			rotate.WaitWithDefaultTimeout()
+			Expect(rotate).To(ExitCleanly())
+			Expect(rotate.OutputToString()).To(Equal(ctrName))
+			Expect(logPath + ".3.gz").ToNot(BeAnExistingFile())
+		}
+	})
+
+	It("podman logs with json-file log driver stores records", func() {
+		ctrName := "logsctr"
+		// conmon writes the first half of the line as a partial line
+		script := `printf '{"level":'; sleep 1; echo '"error"}'; echo plain >&2`
+		logc := podmanTest.Podman([]string{"run", "--log-driver", "json-file", "--name", ctrName, ALPINE, "sh", "-c", script})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(ExitCleanly())
+
+		results := podmanTest.Podman([]string{"logs", "--filter", "level=error", ctrName})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(ExitCleanly())
+		Expect(results.OutputToString()).To(Equal(`{"level":"error"}`))
+
+		inspect := podmanTest.Podman([]string{"container", "inspect", "--format", "{{.HostConfig.LogConfig.Path}}", ctrName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(ExitCleanly())
+		content, err := os.ReadFile(inspect.OutputToString())
+		Expect(err).ToNot(HaveOccurred())
+		records := strings.Split(strings.TrimSpace(string(content)), "\n")
+		Expect(records).To(HaveLen(2))
+		Expect(records[0]).To(ContainSubstring(`"stream":"stdout","log":"{\"level\":\"error\"}","fields":{"level":"error"}}`))
+		Expect(records[1]).To(ContainSubstring(`"stream":"stderr","log":"plain"}`))
+	})
+
+	It("podman logs with json-file log driver keeps the log within max-size", func() {
+		ctrName := "logsctr"
+		logc := podmanTest.Podman([]string{"run", "--log-driver", "json-file", "--log-opt", "max-size=1k", "--log-opt", "max-file=2", "--name", ctrName, ALPINE, "seq", "1", "200"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"container", "inspect", "--format", "{{.HostConfig.LogConfig.Path}}", ctrName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).To(ExitCleanly())
+		logPath := inspect.OutputToString()
+
+		results := podmanTest.Podman([]string{"logs", "--tail", "1", ctrName})
+		results.WaitWithDefaultTimeout()
+		Expect(results).To(ExitCleanly())
+		Expect(results.OutputToString()).To(Equal("200"))
+
+		for _, path := range []string{logPath, logPath + ".1", logPath + ".2"} {
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Size()).To(BeNumerically("<=", 1024))
+		}
+		Expect(logPath + ".3").ToNot(BeAnExistingFile())
+		// reading the log does not write any file
+		Expect(logPath + ".lock").ToNot(BeAnExistingFile())
+		Expect(logPath + ".ingested").ToNot(BeAnExistingFile())
+	})
+
 	It("podman logs with log-driver=none errors", func() {
 		ctrName := "logsctr"
 		logc := podmanTest.Podman([]string{"run", "--name", ctrName, "-d", "--log-driver", "none", ALPINE, "top"})
@@ -281,6 +281,30 @@ var _ = Describe("Podman network connect and disconnect", func() {
 		Expect(exec).Should(ExitCleanly())
 	})
 
+	It("podman network connect with QoS", func() {
+		netName := "qos" + stringid.GenerateRandomID()
+		session := podmanTest.Podman([]string{"network", "create", netName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		defer podmanTest.removeNetwork(netName)
+
+		ctr := podmanTest.Podman([]string{"run", "-d", "--name", "test", "--network", "bridge:egress_rate=10mbit,latency=10ms", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).Should(ExitCleanly())
+
+		connect := podmanTest.Podman([]string{"network", "connect", "--loss", "200%", netName, "test"})
+		connect.WaitWithDefaultTimeout()
+		Expect(connect).Should(ExitWithError(125, `invalid options for network `+netName+`: network option loss: invalid loss "200%", must be a percentage between 0 and 100`))
+
+		connect = podmanTest.Podman([]string{"network", "connect", "--ingress-rate", "5mbit", "--egress-rate", "1mbps", "--loss", "1%", netName, "test"})
+		connect.WaitWithDefaultTimeout()
+		Expect(connect).Should(ExitCleanly())
+
+		exec := podmanTest.Podman([]string{"exec", "test", "ip", "addr", "show", "eth1"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec).Should(ExitCleanly())
+	})
+
 	It("podman network connect and run with network ID", func() {
 		netName := "ID" + stringid.GenerateRandomID()
 		session := podmanTest.Podman([]string{"network", "create", netName})
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/containers/podman/v6/pkg/domain/entities"
@@ -437,6 +439,56 @@ var _ = Describe("Podman network", func() {
 		Expect(session).Should(ExitCleanly())
 	})
 
+	It("podman network export and import", func() {
+		netName := "export-" + stringid.GenerateRandomID()
+		session := podmanTest.Podman([]string{"network", "create", "--subnet", "10.11.13.0/24", "--label", "env=dev", "--dns", "1.1.1.1", netName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		defer podmanTest.removeNetwork(netName)
+
+		for _, format := range []string{"json", "yaml"} {
+			file := filepath.Join(podmanTest.TempDir, "networks."+format)
+			session = podmanTest.Podman([]string{"network", "export", "--format", format, "--output", file, netName})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+
+			// the network and its subnet already exist
+			session = podmanTest.Podman([]string{"network", "import", file})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitWithError(125, "network "+netName+" already exists"))
+
+			session = podmanTest.Podman([]string{"network", "import", "--ignore", file})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(BeEmpty())
+
+			podmanTest.removeNetwork(netName)
+
+			session = podmanTest.Podman([]string{"network", "import", file})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(Equal(netName))
+
+			inspect := podmanTest.Podman([]string{"network", "inspect", "--format", "{{range .Subnets}}{{.Subnet}}{{end}} {{.Labels.env}} {{.NetworkDNSServers}}", netName})
+			inspect.WaitWithDefaultTimeout()
+			Expect(inspect).Should(ExitCleanly())
+			Expect(inspect.OutputToString()).To(Equal("10.11.13.0/24 dev [1.1.1.1]"))
+		}
+
+		// a different network with an overlapping subnet is a conflict
+		session = podmanTest.Podman([]string{"network", "export", netName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(BeValidJSON())
+		file := filepath.Join(podmanTest.TempDir, "conflict.json")
+		err := os.WriteFile(file, []byte(strings.ReplaceAll(session.OutputToString(), netName, netName+"-2")), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+
+		session = podmanTest.Podman([]string{"network", "import", file})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "subnet 10.11.13.0/24 of network "+netName+"-2 overlaps with subnet 10.11.13.0/24 of network "+netName))
+	})
+
 	It("podman network remove bogus", func() {
 		session := podmanTest.Podman([]string{"network", "rm", "bogus"})
 		session.WaitWithDefaultTimeout()
@@ -0,0 +1,102 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	. "github.com/containers/podman/v6/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Podman pod update", func() {
+	It("podman pod update resources", func() {
+		SkipIfRootless("many of these handlers are not enabled while rootless in CI")
+		podCreate := podmanTest.Podman([]string{"pod", "create", "--cpus", "1"})
+		podCreate.WaitWithDefaultTimeout()
+		Expect(podCreate).Should(ExitCleanly())
+		podID := podCreate.OutputToString()
+
+		session := podmanTest.Podman([]string{"run", "-d", "--pod", podID, ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "update", "--cpus", "2", "--memory", "1G", podID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal(podID))
+
+		podInspect := podmanTest.Podman([]string{"pod", "inspect", podID})
+		podInspect.WaitWithDefaultTimeout()
+		Expect(podInspect).Should(ExitCleanly())
+		data := podInspect.InspectPodToJSON()
+		Expect(data.CPUQuota).To(Equal(int64(200000)))
+		Expect(data.CPUPeriod).To(Equal(uint64(100000)))
+		Expect(data.MemoryLimit).To(Equal(uint64(1073741824)))
+
+		// The limits must survive a restart of the pod.
+		session = podmanTest.Podman([]string{"pod", "restart", podID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		podInspect = podmanTest.Podman([]string{"pod", "inspect", podID})
+		podInspect.WaitWithDefaultTimeout()
+		Expect(podInspect).Should(ExitCleanly())
+		data = podInspect.InspectPodToJSON()
+		Expect(data.CPUQuota).To(Equal(int64(200000)))
+		Expect(data.MemoryLimit).To(Equal(uint64(1073741824)))
+	})
+
+	It("podman pod update restart policy and labels", func() {
+		podCreate := podmanTest.Podman([]string{"pod", "create", "--label", "a=1", "--label", "b=2"})
+		podCreate.WaitWithDefaultTimeout()
+		Expect(podCreate).Should(ExitCleanly())
+		podID := podCreate.OutputToString()
+
+		session := podmanTest.Podman([]string{"create", "--name", "inherit", "--pod", podID, ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"create", "--name", "own", "--restart", "on-failure", "--pod", podID, ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "update", "--restart", "always", "--label", "c=3", "--unsetlabel", "b", podID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		podInspect := podmanTest.Podman([]string{"pod", "inspect", podID})
+		podInspect.WaitWithDefaultTimeout()
+		Expect(podInspect).Should(ExitCleanly())
+		data := podInspect.InspectPodToJSON()
+		Expect(data.RestartPolicy).To(Equal("always"))
+		Expect(data.Labels).To(HaveKeyWithValue("a", "1"))
+		Expect(data.Labels).To(HaveKeyWithValue("c", "3"))
+		Expect(data.Labels).ToNot(HaveKey("b"))
+
+		for ctr, policy := range map[string]string{data.InfraContainerID: "always", "inherit": "always", "own": "on-failure"} {
+			session = podmanTest.Podman([]string{"container", "inspect", "--format", "{{.HostConfig.RestartPolicy.Name}}", ctr})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(Equal(policy), ctr)
+		}
+	})
+
+	It("podman pod update errors", func() {
+		session := podmanTest.Podman([]string{"pod", "update", "--cpus", "1", "bogus"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, `no pod with name or ID bogus found: no such pod`))
+
+		podCreate := podmanTest.Podman([]string{"pod", "create"})
+		podCreate.WaitWithDefaultTimeout()
+		Expect(podCreate).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "update", podCreate.OutputToString()})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "no changes specified"))
+
+		session = podmanTest.Podman([]string{"pod", "update", "--restart", "bogus", podCreate.OutputToString()})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+	})
+})
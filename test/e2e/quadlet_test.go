@@ -907,6 +907,7 @@ BOGUS=foo
 		Entry("dns-options.container", "dns-options.container"),
 		Entry("dns-search.container", "dns-search.container"),
 		Entry("dns.container", "dns.container"),
+		Entry("egress-allow.container", "egress-allow.container"),
 		Entry("env-file.container", "env-file.container"),
 		Entry("env-host-false.container", "env-host-false.container"),
 		Entry("env-host.container", "env-host.container"),
@@ -916,6 +917,8 @@ BOGUS=foo
 		Entry("exec.container", "exec.container"),
 		Entry("group-add.container", "group-add.container"),
 		Entry("health.container", "health.container"),
+		Entry("health-notify.container", "health-notify.container"),
+		Entry("schedule.container", "schedule.container"),
 		Entry("host.container", "host.container"),
 		Entry("httpproxy-false.container", "httpproxy-false.container"),
 		Entry("httpproxy-true.container", "httpproxy-true.container"),
@@ -948,6 +951,7 @@ BOGUS=foo
 		Entry("readwrite-notmpfs.container", "readwrite-notmpfs.container"),
 		Entry("volatiletmp-readwrite.container", "volatiletmp-readwrite.container"),
 		Entry("volatiletmp-readonly.container", "volatiletmp-readonly.container"),
+		Entry("requires-healthy.container", "requires-healthy.container"),
 		Entry("remap-auto.container", "remap-auto.container"),
 		Entry("remap-auto2.container", "remap-auto2.container"),
 		Entry("remap-keep-id.container", "remap-keep-id.container"),
@@ -1197,6 +1201,7 @@ BOGUS=foo
 		},
 		Entry("Container - Mount", "mount.container", []string{"basic.image", "basic.volume", "basic.artifact"}),
 		Entry("Container - Quadlet Network", "network.quadlet.container", []string{"basic.network"}),
+		Entry("Container - Quadlet Network with QoS", "network.quadlet.qos.container", []string{"basic.network"}),
 		Entry("Container - Quadlet Volume", "volume.container", []string{"basic.volume"}),
 		Entry("Container - Mount overriding service name", "mount.servicename.container", []string{"service-name.volume"}),
 		Entry("Container - Quadlet Network overriding service name", "network.quadlet.servicename.container", []string{"service-name.network"}),
@@ -1207,6 +1212,7 @@ BOGUS=foo
 		Entry("Container - Reuse another named container's network", "network.reuse.name.container", []string{"name.container"}),
 		Entry("Container - Reuse another container's network", "a.network.reuse.container", []string{"basic.container"}),
 		Entry("Container - Reuse another named container's network", "a.network.reuse.name.container", []string{"name.container"}),
+		Entry("Container - Require another container to be healthy", "requires-healthy.quadlet.container", []string{"basic.container"}),
 		Entry(
 			"Container - Dependency between quadlet units",
 			"dependent.container",
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"os/exec"
 	"strconv"
 	"strings"
 	"sync"
@@ -192,6 +193,89 @@ var _ = Describe("Podman run networking", func() {
 		Expect(session.OutputToString()).To(ContainSubstring("nameserver 1.1.1.1"))
 	})
 
+	It("podman run --egress-allow", func() {
+		if _, err := exec.LookPath("nft"); err != nil {
+			Skip("nft is required for --egress-allow")
+		}
+		net := createNetworkName("egress")
+		session := podmanTest.Podman([]string{"network", "create", net})
+		session.WaitWithDefaultTimeout()
+		defer podmanTest.removeNetwork(net)
+		Expect(session).Should(ExitCleanly())
+
+		server := podmanTest.Podman([]string{"run", "-d", "--network", net, ALPINE, "top"})
+		server.WaitWithDefaultTimeout()
+		Expect(server).Should(ExitCleanly())
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{(index .NetworkSettings.Networks \"" + net + "\").IPAddress}}", server.OutputToString()})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		serverIP := inspect.OutputToString()
+
+		session = podmanTest.Podman([]string{"run", "--network", net, "--egress-allow", serverIP, ALPINE, "ping", "-c1", "-W2", serverIP})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		// traffic to destinations which are not allowed is dropped
+		session = podmanTest.Podman([]string{"run", "--network", net, "--egress-allow", "192.0.2.1:443", ALPINE, "ping", "-c1", "-W2", serverIP})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(1))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--network", net, "--egress-allow", "192.0.2.0/24", "--egress-allow", "192.0.2.1:443", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		inspect = podmanTest.Podman([]string{"inspect", "--format", "{{.HostConfig.EgressAllow}} {{range .NetworkSettings.EgressRules}}{{.Destinations}}:{{.Port}} {{end}}", session.OutputToString()})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("[192.0.2.0/24 192.0.2.1:443] [192.0.2.0/24]:0 [192.0.2.1]:443"))
+
+		exec := podmanTest.Podman([]string{"exec", "--privileged", session.OutputToString(), "true"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec).Should(ExitWithError(125, "cannot create privileged exec sessions in a container with an egress allow list: invalid argument"))
+
+		session = podmanTest.Podman([]string{"run", "--network", "host", "--egress-allow", "192.0.2.1", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "an egress allow list requires bridge or pasta networking: invalid argument"))
+
+		for _, privileges := range [][]string{{"--cap-add", "NET_ADMIN"}, {"--privileged"}} {
+			session = podmanTest.Podman(append(append([]string{"run", "--egress-allow", "192.0.2.1"}, privileges...), ALPINE, "true"))
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitWithError(125, "an egress allow list cannot be enforced for a container with the CAP_NET_ADMIN capability: invalid argument"))
+		}
+
+		session = podmanTest.Podman([]string{"run", "--egress-allow", "192.0.2.1:0", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, `invalid egress allow entry "192.0.2.1:0": invalid port "0": invalid argument`))
+	})
+
+	It("podman run --egress-allow allows the nameservers of resolv.conf", func() {
+		SkipIfRootless("nsenter into the network namespace requires root")
+		if _, err := exec.LookPath("nft"); err != nil {
+			Skip("nft is required for --egress-allow")
+		}
+		// the default network has no DNS server, so the nameservers of
+		// the host are written to resolv.conf
+		session := podmanTest.Podman([]string{"run", "-d", "--egress-allow", "192.0.2.1", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		resolvConf := podmanTest.Podman([]string{"exec", cid, "awk", "/^nameserver/ {print $2}", "/etc/resolv.conf"})
+		resolvConf.WaitWithDefaultTimeout()
+		Expect(resolvConf).Should(ExitCleanly())
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.Pid}}", cid})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+
+		ruleset := SystemExec("nsenter", []string{"-t", inspect.OutputToString(), "-n", "nft", "list", "table", "inet", "podman_egress"})
+		Expect(ruleset).Should(ExitCleanly())
+		for _, nameserver := range resolvConf.OutputToStringArray() {
+			if strings.Contains(nameserver, "%") {
+				continue
+			}
+			Expect(ruleset.OutputToString()).To(ContainSubstring("daddr " + nameserver + " udp dport 53 accept"))
+		}
+	})
+
 	It("podman run -p 80", func() {
 		name := "testctr"
 		session := podmanTest.Podman([]string{"create", "-t", "-p", "80", "--name", name, ALPINE, "/bin/sh"})
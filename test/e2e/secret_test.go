@@ -472,6 +472,71 @@ var _ = Describe("Podman secret", func() {
 		Expect(inspect.OutputToString()).To(Equal("map[]"))
 	})
 
+	It("podman secret update", func() {
+		secretFilePath := filepath.Join(podmanTest.TempDir, "secret")
+		err := os.WriteFile(secretFilePath, []byte("olddata"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+
+		session := podmanTest.Podman([]string{"secret", "update", "bogus", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, `no secret with name or id "bogus": no such secret`))
+
+		session = podmanTest.Podman([]string{"secret", "create", "--label", "foo=bar", "a", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		oldID := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"run", "-d", "--name", "mounted", "--secret", "a", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		session = podmanTest.Podman([]string{"create", "--name", "env", "--secret", "a,type=env,target=SECRET", ALPINE, "printenv", "SECRET"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		// Data which cannot be read fully does not update the secret.
+		session = podmanTest.Podman([]string{"secret", "update", "a", podmanTest.TempDir})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "is a directory"))
+
+		session = podmanTest.Podman([]string{"secret", "update", "--restart", "--signal", "SIGHUP", "a", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "if any flags in the group [restart signal] are set none of the others can be"))
+
+		err = os.WriteFile(secretFilePath, []byte("newdata"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+		session = podmanTest.Podman([]string{"secret", "update", "--signal", "SIGHUP", "a", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		newID := session.OutputToString()
+		Expect(newID).ToNot(Equal(oldID))
+
+		inspect := podmanTest.Podman([]string{"secret", "inspect", "--format", "{{.ID}} {{.Version.Index}} {{.Spec.Labels}}", "a"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal(newID + " 2 map[foo:bar]"))
+
+		result := podmanTest.Podman([]string{"events", "--stream=false", "--filter", "type=secret"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+		Expect(result.OutputToStringArray()).To(ContainElement(ContainSubstring(" secret update %s", newID)))
+
+		// The running container sees the new data without being restarted.
+		session = podmanTest.Podman([]string{"exec", "mounted", "cat", "/run/secrets/a"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("newdata"))
+
+		session = podmanTest.Podman([]string{"start", "-a", "env"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("newdata"))
+
+		session = podmanTest.Podman([]string{"inspect", "--format", "{{(index .Config.Secrets 0).ID}}", "mounted"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal(newID))
+	})
+
 	It("podman secret exists should return true if secret exists", func() {
 		secretFilePath := filepath.Join(podmanTest.TempDir, "secret")
 		err := os.WriteFile(secretFilePath, []byte("mysecret"), 0o755)
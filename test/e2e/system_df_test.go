@@ -3,6 +3,8 @@
 package integration
 
 import (
+	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -97,6 +99,26 @@ var _ = Describe("podman system df", func() {
 		Expect(session).To(ExitWithError(125, "Error: cannot combine --format and --verbose flags"))
 	})
 
+	It("podman system df --verbose shared layers", func() {
+		podmanTest.AddImageToRWStore(ALPINE)
+		dockerfile := fmt.Sprintf("FROM %s\nRUN touch /file\n", ALPINE)
+		podmanTest.BuildImage(dockerfile, "localhost/df-child", "false")
+
+		session := podmanTest.PodmanExitCleanly("system", "df", "--verbose")
+		output := session.OutputToString()
+		Expect(output).To(ContainSubstring("Shared layers space usage:"))
+		Expect(output).To(ContainSubstring("Artifacts space usage:"))
+		Expect(output).To(ContainSubstring("Build cache space usage:"))
+
+		// The base layer of alpine is shared with the child image
+		alpineID := podmanTest.PodmanExitCleanly("image", "inspect", "--format", "{{.ID}}", ALPINE).OutputToString()
+		childID := podmanTest.PodmanExitCleanly("image", "inspect", "--format", "{{.ID}}", "localhost/df-child").OutputToString()
+		lines := session.OutputToStringArray()
+		start := slices.Index(lines, "Shared layers space usage:")
+		Expect(lines[start+1]).To(HavePrefix("LAYER ID"))
+		Expect(lines[start+2:]).To(ContainElement(And(ContainSubstring(alpineID[:12]), ContainSubstring(childID[:12]))))
+	})
+
 	It("podman system df --format json", func() {
 		session := podmanTest.Podman([]string{"create", ALPINE})
 		session.WaitWithDefaultTimeout()
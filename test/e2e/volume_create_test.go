@@ -143,6 +143,20 @@ var _ = Describe("Podman volume create", func() {
 		Expect(session).To(ExitWithError(125, "invalid mount option badOpt for driver 'local': invalid argument"))
 	})
 
+	It("podman create volume with bad quota-backend option", func() {
+		session := podmanTest.Podman([]string{"volume", "create", "--opt", "o=size=8m", "--opt", "quota-backend=bogus"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, `invalid quota backend "bogus", must be one of auto, xfs or loop: invalid argument`))
+
+		session = podmanTest.Podman([]string{"volume", "create", "--opt", "quota-backend=loop"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume option quota-backend requires the size or inodes option"))
+
+		session = podmanTest.Podman([]string{"volume", "create", "--opt", "o=size=8m,noquota", "--opt", "quota-backend=loop"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume options size and inodes cannot be used without quota"))
+	})
+
 	It("podman create volume with o=uid,gid", func() {
 		volName := "testVol"
 		uid := "3000"
@@ -0,0 +1,112 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	. "github.com/containers/podman/v6/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Podman volume snapshot", func() {
+	AfterEach(func() {
+		podmanTest.CleanupVolume()
+	})
+
+	It("podman volume snapshot and rollback", func() {
+		podmanTest.PodmanExitCleanly("volume", "create", "--label", "app=db", "myvol")
+		podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myvol:/data", ALPINE, "sh", "-c", "echo clean > /data/state")
+
+		session := podmanTest.PodmanExitCleanly("volume", "snapshot", "myvol", "myvol-clean")
+		Expect(session.OutputToString()).To(Equal("myvol-clean"))
+
+		session = podmanTest.PodmanExitCleanly("volume", "inspect", "--format", "{{.SnapshotOf}} {{.Labels.app}}", "myvol-clean")
+		Expect(session.OutputToString()).To(Equal("myvol db"))
+
+		podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myvol:/data", ALPINE, "sh", "-c", "echo dirty > /data/state; touch /data/new")
+
+		// A snapshot without a name is the most recent one
+		session = podmanTest.PodmanExitCleanly("volume", "snapshot", "myvol")
+		dirty := session.OutputToString()
+		Expect(dirty).To(HavePrefix("myvol-snapshot-"))
+
+		session = podmanTest.PodmanExitCleanly("volume", "rollback", "myvol", "myvol-clean")
+		Expect(session.OutputToString()).To(Equal("myvol-clean"))
+		session = podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myvol:/data", ALPINE, "ls", "/data")
+		Expect(session.OutputToStringArray()).To(Equal([]string{"state"}))
+		session = podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myvol:/data", ALPINE, "cat", "/data/state")
+		Expect(session.OutputToString()).To(Equal("clean"))
+
+		session = podmanTest.PodmanExitCleanly("volume", "rollback", "myvol")
+		Expect(session.OutputToString()).To(Equal(dirty))
+		session = podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myvol:/data", ALPINE, "cat", "/data/state")
+		Expect(session.OutputToString()).To(Equal("dirty"))
+
+		// The snapshot is not changed by the volume
+		session = podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myvol-clean:/data", ALPINE, "cat", "/data/state")
+		Expect(session.OutputToString()).To(Equal("clean"))
+	})
+
+	It("podman volume rollback errors", func() {
+		podmanTest.PodmanExitCleanly("volume", "create", "myvol")
+		podmanTest.PodmanExitCleanly("volume", "create", "other")
+
+		session := podmanTest.Podman([]string{"volume", "rollback", "myvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume myvol has no snapshots: no such volume"))
+
+		session = podmanTest.Podman([]string{"volume", "rollback", "myvol", "other"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume other is not a snapshot of volume myvol: invalid argument"))
+
+		podmanTest.PodmanExitCleanly("volume", "snapshot", "myvol", "myvol-snap")
+		session = podmanTest.PodmanExitCleanly("run", "-d", "-v", "myvol:/data", ALPINE, "top")
+		ctrID := session.OutputToString()
+		session = podmanTest.Podman([]string{"volume", "rollback", "myvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume myvol is being used by running container "+ctrID+": volume is being used"))
+		// A volume used by a running container is only copied by force
+		session = podmanTest.Podman([]string{"volume", "snapshot", "myvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume myvol is being used by running container "+ctrID+": volume is being used"))
+		session = podmanTest.Podman([]string{"volume", "clone", "myvol", "myclone"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume myvol is being used by running container "+ctrID+": volume is being used"))
+		podmanTest.PodmanExitCleanly("volume", "snapshot", "--force", "myvol", "myvol-forced")
+		podmanTest.PodmanExitCleanly("volume", "clone", "--force", "myvol", "myclone")
+		podmanTest.PodmanExitCleanly("rm", "-f", "-t0", ctrID)
+		podmanTest.PodmanExitCleanly("volume", "rollback", "myvol")
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "myvol", "other"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume with name other already exists"))
+
+		podmanTest.PodmanExitCleanly("volume", "create", "--opt", "type=tmpfs", "--opt", "device=tmpfs", "tmpvol")
+		session = podmanTest.Podman([]string{"volume", "snapshot", "tmpvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume tmpvol mounts a filesystem with the"))
+	})
+
+	It("podman volume clone", func() {
+		podmanTest.PodmanExitCleanly("volume", "create", "myvol")
+		podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myvol:/data", ALPINE, "sh", "-c", "mkdir /data/dir && echo hello > /data/dir/file && chown 1000:1000 /data/dir/file")
+
+		session := podmanTest.PodmanExitCleanly("volume", "clone", "myvol", "myclone")
+		Expect(session.OutputToString()).To(Equal("myclone"))
+
+		session = podmanTest.PodmanExitCleanly("volume", "inspect", "--format", "{{.ClonedFrom}}:{{.SnapshotOf}}", "myclone")
+		Expect(session.OutputToString()).To(Equal("myvol:"))
+
+		session = podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myclone:/data", ALPINE, "stat", "-c", "%u:%g %n", "/data/dir/file")
+		Expect(session.OutputToString()).To(Equal("1000:1000 /data/dir/file"))
+
+		// The clone is independent of the volume
+		podmanTest.PodmanExitCleanly("volume", "rm", "myvol")
+		session = podmanTest.PodmanExitCleanly("run", "--rm", "-v", "myclone:/data", ALPINE, "cat", "/data/dir/file")
+		Expect(session.OutputToString()).To(Equal("hello"))
+
+		session = podmanTest.Podman([]string{"volume", "clone", "myclone"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "accepts 2 arg(s), received 1"))
+	})
+})
@@ -443,3 +443,34 @@ EOF
     run_podman 125 events --since="the dawn of time...ish"
     assert "$output" =~ "failed to parse event filters"
 }
+
+# CANNOT BE PARALLELIZED - #23750, events-backend=file cannot coexist with journal
+@test "events - forward to unix socket" {
+    skip_if_remote "remote does not support --events-backend"
+
+    local socket=$PODMAN_TMPDIR/events.sock
+    local log=$PODMAN_TMPDIR/events.json
+    # Execute in subshell so we can close fd3 (which BATS uses).
+    (exec socat unix-listen:"$socket",fork open:"$log",creat,append 3>&-) &
+    local socat_pid=$!
+    wait_for_file $socket
+
+    local cname=c-$(safename)
+    run_podman --events-backend=file+unix://$socket create --name $cname $IMAGE true
+    run_podman --events-backend=file+unix://$socket rm $cname
+
+    wait_for_file_content $log '"Status":"remove"'
+    kill $socat_pid
+    run jq -r 'select(.Name == "'$cname'") | .Status' $log
+    assert "$output" == $'create\nremove' "events forwarded to the socket"
+
+    # Forwarded events are stored as well
+    run_podman --events-backend=file+unix://$socket events --stream=false --filter container=$cname
+    assert "$output" =~ "container remove" "forwarded events are read from the file backend"
+
+    # An unreachable endpoint does not fail or stall the command
+    run_podman --events-backend=file+unix://$socket create --name $cname $IMAGE true
+    run_podman --events-backend=file+unix://$socket rm $cname
+    run_podman --events-backend=file+unix://$socket events --stream=false --filter container=$cname --filter event=create
+    assert "${#lines[@]}" = 2 "events are stored while the endpoint is unreachable"
+}
@@ -1,6 +1,6 @@
 #!/usr/bin/env bats   -*- bats -*-
 #
-# podman volume XFS quota tests
+# podman volume quota tests
 #
 
 load helpers
@@ -85,4 +85,71 @@ function teardown() {
     run_podman $safe_opts volume rm -af
 }
 
+@test "podman volumes with loop device quotas" {
+    skip_if_rootless "Loop devices are only possible with root"
+    skip_if_remote "Checks the volume image on the host"
+
+    vol="testvol-$(safename)"
+    run_podman volume create --opt o=size=8m --opt quota-backend=loop $vol
+    run_podman volume inspect --format '{{.Quota.Backend}} {{.Quota.Size}}' $vol
+    assert "$output" == "loop 8388608" "quota backend and size"
+    run_podman volume inspect --format '{{.Mountpoint}}' $vol
+    vol_image=$(dirname $output)/volume.img
+    test -f $vol_image || die "volume image $vol_image does not exist"
+
+    ctrname="c-$(safename)"
+    run_podman run -d --name=$ctrname -v $vol:/vol $IMAGE top
+    run_podman exec $ctrname dd if=/dev/zero of=/vol/fourMB bs=1M count=4
+    run_podman 1 exec $ctrname dd if=/dev/zero of=/vol/eightMB bs=1M count=8
+    assert "$output" =~ "No space left on device"
+
+    run_podman volume inspect --format '{{.Quota.Usage}}' $vol
+    assert "$output" -gt 4194304 "usage includes the written file"
+
+    run_podman rm -f -t 0 $ctrname
+    run_podman volume rm $vol
+    test -e $vol_image && die "volume image $vol_image was not removed"
+
+    run_podman 125 volume create --opt quota-backend=loop $vol
+    assert "$output" =~ "quota-backend requires the size or inodes option"
+    run_podman 125 volume create --opt o=size=8m --opt quota-backend=bogus $vol
+    assert "$output" =~ 'invalid quota backend "bogus"'
+}
+
+@test "podman volume rollback of a volume with a loop device quota" {
+    skip_if_rootless "Loop devices are only possible with root"
+
+    vol="testvol-$(safename)"
+    run_podman volume create --opt o=size=8m --opt quota-backend=loop $vol
+    # more than half of the volume is used, it has no room for a second copy
+    run_podman run --rm -v $vol:/vol $IMAGE sh -c "dd if=/dev/zero of=/vol/fiveMB bs=1M count=5 && echo clean > /vol/state"
+    run_podman volume snapshot $vol
+    snapshot="$output"
+
+    run_podman run --rm -v $vol:/vol $IMAGE sh -c "echo dirty > /vol/state"
+    run_podman volume rollback $vol
+    assert "$output" == "$snapshot" "restored snapshot"
+    run_podman run --rm -v $vol:/vol $IMAGE sh -c "cat /vol/state; stat -c %s /vol/fiveMB"
+    assert "$output" == "clean
+5242880" "rolled back contents"
+
+    run_podman volume rm $vol $snapshot
+}
+
+@test "podman volume quotas require root" {
+    skip_if_not_rootless "Rootless users cannot set quotas"
+
+    vol="testvol-$(safename)"
+    for opt in size=8m inodes=100; do
+        run_podman 125 volume create --opt o=$opt $vol
+        assert "$output" =~ "volume options size and inodes require root privileges" "o=$opt"
+    done
+
+    # tmpfs volumes are limited by the tmpfs mount
+    run_podman volume create --opt type=tmpfs --opt device=tmpfs --opt o=size=8m $vol
+    run_podman volume inspect --format '{{.Quota.Backend}}' $vol
+    assert "$output" == "tmpfs" "quota backend"
+    run_podman volume rm $vol
+}
+
 # vim: filetype=sh
@@ -290,6 +290,67 @@ Log[-1].Output   | \"Uh-oh on stdout!\\\nUh-oh on stderr!\\\n\"
     done
 }
 
+@test "podman healthcheck --health-on-failure=notify" {
+    run_podman 125 create --health-cmd /bin/false --health-on-failure=notify $IMAGE
+    is "$output" "Error: on-failure action notify requires a hook: invalid argument"
+    run_podman 125 create --health-cmd /bin/false --health-on-failure=kill --health-on-failure-hook=/bin/true $IMAGE
+    is "$output" "Error: --health-on-failure-hook requires --health-on-failure=notify: invalid configuration"
+
+    ctr="c-h-$(safename)"
+    hookout=$PODMAN_TMPDIR/hook.out
+
+    # The hook inspects the container, which must not be locked meanwhile
+    hook=$PODMAN_TMPDIR/hook.sh
+    cat >$hook <<EOF
+#!/bin/sh
+echo \$PODMAN_CONTAINER_NAME \$PODMAN_HEALTH_STATUS >>$hookout
+${PODMAN_CMD[*]} inspect --format {{.State.Status}} \$PODMAN_CONTAINER_ID >$hookout.inspect
+cat >>$hookout
+echo >>$hookout
+EOF
+    chmod +x $hook
+
+    run_podman run -d --name $ctr                 \
+           --health-cmd /home/podman/healthcheck  \
+           --health-retries=2                     \
+           --health-on-failure=notify             \
+           --health-on-failure-hook=$hook         \
+           --health-timeout=10s                   \
+           --health-interval=disable              \
+           $IMAGE /home/podman/pause
+    cid="$output"
+
+    run_podman inspect $ctr --format "{{.Config.HealthcheckOnFailureAction}}"
+    is "$output" "notify" "on-failure action"
+
+    run_podman healthcheck run $ctr
+    run_podman exec $ctr touch /uh-oh
+
+    # The first failure does not turn the container unhealthy yet
+    run_podman 1 healthcheck run $ctr
+    assert "$(cat $hookout 2>/dev/null)" == "" "hook must not run before container is unhealthy"
+
+    run_podman 1 healthcheck run $ctr
+    is "$output" "unhealthy" "output from 'podman healthcheck run'"
+    run head -n1 $hookout
+    is "$output" "$ctr unhealthy" "hook environment"
+    run tail -n1 $hookout
+    assert "$output" =~ "\"ID\":\"$cid\"" "hook payload holds container ID"
+    assert "$output" =~ "\"FailingStreak\":2" "hook payload holds failing streak"
+    is "$(< $hookout.inspect)" "running" "hook inspected the container"
+
+    # Still unhealthy, the hook must not run again
+    run_podman 1 healthcheck run $ctr
+    run wc -l < $hookout
+    is "$output" "2" "hook ran exactly once"
+
+    # The container keeps running
+    run_podman inspect $ctr --format "{{.State.Status}}"
+    is "$output" "running" "container continued running"
+
+    run_podman rm -f -t0 $ctr
+}
+
 function _create_container_with_health_log_settings {
     local ctrname="$1"
     local msg="$2"
@@ -487,4 +548,65 @@ stderr" "systemd-run error message"
     run_podman rm -f -t0 $ctr1 $ctr2
 }
 
+@test "podman run --requires-healthy" {
+    dep="c-dep-$(safename)"
+    ctr="c-ctr-$(safename)"
+    nohc="c-nohc-$(safename)"
+
+    # The dependency only turns healthy once the marker file exists
+    run_podman run -d --name $dep                 \
+           --health-cmd "test -e /tmp/healthy" \
+           --health-interval 1s                 \
+           $IMAGE /home/podman/pause
+    depid="$output"
+
+    run_podman create --name $ctr --requires-healthy $dep \
+           --requires-healthy-timeout 3s $IMAGE true
+    run_podman inspect $ctr --format "{{.HealthyDependencies}}"
+    assert "$output" =~ "$depid" "dependency listed in inspect"
+
+    run_podman 125 start $ctr
+    assert "$output" =~ "did not become healthy within 3s" "start times out while dependency is unhealthy"
+
+    run_podman exec $dep touch /tmp/healthy
+    run_podman start --attach $ctr
+
+    run_podman run -d --name $nohc $IMAGE /home/podman/pause
+    run_podman 125 create --requires-healthy $nohc $IMAGE true
+    assert "$output" =~ "has no healthcheck" "dependency without healthcheck is rejected"
+
+    run_podman rm -f -t0 $ctr $dep $nohc
+}
+
+@test "podman pod start --requires-healthy does not block the pod" {
+    pod="p-$(safename)"
+    dep="c-dep-$(safename)"
+    ctr="c-ctr-$(safename)"
+
+    run_podman pod create --name $pod
+    # The dependency never turns healthy
+    run_podman create --pod $pod --name $dep \
+           --health-cmd "false" --health-interval 1s \
+           $IMAGE /home/podman/pause
+    run_podman create --pod $pod --name $ctr --requires-healthy $dep \
+           --requires-healthy-timeout 60s $IMAGE /home/podman/pause
+
+    # Waiting for the dependency must not hold the lock of the pod
+    timeout --foreground -v --kill=10 90 \
+        $PODMAN pod start $pod &> $PODMAN_TMPDIR/pod-start.log &
+    start_pid=$!
+    run_podman container wait --condition=running $dep
+
+    SECONDS=0
+    run_podman pod stop -t 0 $pod
+    assert $SECONDS -lt 30 "pod stop is not blocked by pod start"
+
+    # pod start fails once the dependency stopped
+    wait $start_pid || true
+    run cat $PODMAN_TMPDIR/pod-start.log
+    assert "$output" =~ "dependency .* of container .* is (exited|stopped)" "pod start error"
+
+    run_podman pod rm -f -t0 $pod
+}
+
 # vim: filetype=sh
@@ -167,6 +167,12 @@ function _confirm_update() {
     run_podman 125 create --label io.containers.autoupdate=registry docker-archive:$archive
     is "$output" ".*Error: auto updates require the docker image transport but image is of transport \"docker-archive\""
 
+    # The semver policy requires a valid version constraint
+    run_podman 125 create --label io.containers.autoupdate=semver $IMAGE
+    assert "$output" =~ "auto-update policy semver requires the io.containers.autoupdate.semver label" "semver without constraint"
+    run_podman create --label io.containers.autoupdate=semver --label io.containers.autoupdate.semver="~1.4" $IMAGE
+    run_podman rm -f "$output"
+
     run_podman rmi $shortname
 }
 
@@ -347,6 +353,120 @@ EOF
     _confirm_update $cname $newID
 }
 
+@test "podman auto-update --wait-healthy with rollback" {
+    dockerfile1=$PODMAN_TMPDIR/Dockerfile.1
+    cat >$dockerfile1 <<EOF
+FROM $IMAGE
+RUN ln -s /bin/true /healthy
+EOF
+
+    dockerfile2=$PODMAN_TMPDIR/Dockerfile.2
+    cat >$dockerfile2 <<EOF
+FROM $IMAGE
+RUN echo broken > /version
+EOF
+    image=test
+
+    # Generate an image passing the healthcheck.
+    run_podman build -t quay.io/libpod/$image -f $dockerfile1
+
+    generate_service $image local "top -d 120" "--health-cmd=/healthy --health-interval=1s --health-retries=1" noTag
+    _wait_service_ready container-$cname.service
+
+    # Generate an image starting fine but failing the healthcheck.
+    run_podman build -t quay.io/libpod/$image -f $dockerfile2
+    run_podman image inspect --format "{{.ID}}" $image
+    newID="$output"
+
+    run_podman auto-update --wait-healthy=30s --format "{{.Unit}},{{.Image}},{{.Updated}},{{.Policy}},{{.Health}}"
+    is "$output" ".*container-$cname.service,quay.io/libpod/$image:latest,rolled back,local,unhealthy.*" "Rolled back unhealthy container"
+
+    # Make sure that new container is not using the new image ID anymore.
+    _confirm_update $cname $newID
+
+    run_podman inspect --format "{{.ID}}" $cname
+    ctrID="$output"
+    run_podman events --filter container=$ctrID --filter event=rollback --stream=false
+    assert "$output" =~ "container rollback" "rollback event for the restarted container"
+}
+
+@test "podman auto-update --wait-healthy without rollback" {
+    dockerfile1=$PODMAN_TMPDIR/Dockerfile.1
+    cat >$dockerfile1 <<EOF
+FROM $IMAGE
+RUN ln -s /bin/true /healthy
+EOF
+
+    dockerfile2=$PODMAN_TMPDIR/Dockerfile.2
+    cat >$dockerfile2 <<EOF
+FROM $IMAGE
+RUN echo broken > /version
+EOF
+    image=test
+
+    # Generate an image passing the healthcheck.
+    run_podman build -t quay.io/libpod/$image -f $dockerfile1
+
+    generate_service $image local "top -d 120" "--health-cmd=/healthy --health-interval=1s --health-retries=1" noTag
+    _wait_service_ready container-$cname.service
+
+    # Generate an image starting fine but failing the healthcheck.
+    run_podman build -t quay.io/libpod/$image -f $dockerfile2
+
+    run_podman 125 auto-update --rollback=false --wait-healthy=30s --format "{{.Unit}},{{.Updated}},{{.Health}}"
+    is "$output" ".*container-$cname.service,failed,unhealthy.*" "Unhealthy container is not rolled back"
+
+    run_podman inspect --format "{{.ID}}" $cname
+    ctrID="$output"
+    run_podman events --filter container=$ctrID --filter event=update-unhealthy --stream=false
+    assert "$output" =~ "container update-unhealthy" "update-unhealthy event"
+}
+
+@test "podman auto-update --canary staged rollout" {
+    dockerfile1=$PODMAN_TMPDIR/Dockerfile.1
+    cat >$dockerfile1 <<EOF
+FROM $IMAGE
+RUN ln -s /bin/true /healthy
+EOF
+
+    dockerfile2=$PODMAN_TMPDIR/Dockerfile.2
+    cat >$dockerfile2 <<EOF
+FROM $IMAGE
+RUN ln -s /bin/true /healthy && echo 2 > /version
+EOF
+
+    dockerfile3=$PODMAN_TMPDIR/Dockerfile.3
+    cat >$dockerfile3 <<EOF
+FROM $IMAGE
+RUN echo broken > /version
+EOF
+    image=staged-$(safename)
+
+    run_podman build -t quay.io/libpod/$image -f $dockerfile1
+
+    local cnames=()
+    for i in 1 2 3; do
+        generate_service $image local "top -d 120" "--health-cmd=/healthy --health-interval=1s --health-retries=1" noTag
+        cnames+=($cname)
+    done
+    for cname in "${cnames[@]}"; do
+        _wait_service_ready container-$cname.service
+    done
+
+    # A good image is rolled out to one unit per stage.
+    run_podman build -t quay.io/libpod/$image -f $dockerfile2
+    run_podman auto-update --canary --batch-size=1 --soak-time=2s --format "{{.Stage}},{{.Updated}}"
+    run sort <<<"$output"
+    assert "$output" == $'1,true\n2,true\n3,true' "units updated in three stages"
+
+    # A broken image fails the canary, which aborts the rollout.
+    run_podman build -t quay.io/libpod/$image -f $dockerfile3
+    run_podman 125 auto-update --canary --wait-healthy=30s --format "{{.Unit}},{{.Stage}},{{.Updated}}"
+    assert "$output" =~ "aborting rollout in stage 1" "rollout aborted"
+    assert "$(grep -c ',0,aborted' <<<"$output")" == "2" "remaining units are not updated"
+    assert "$(grep -c ',1,rolled back' <<<"$output")" == "1" "canary is rolled back"
+}
+
 @test "podman auto-update with multiple services" {
     # Preserve original image ID, to confirm that it changes (or not)
     run_podman inspect --format "{{.Id}}" $IMAGE
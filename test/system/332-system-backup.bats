@@ -0,0 +1,60 @@
+#!/usr/bin/env bats   -*- bats -*-
+#
+# Tests for podman system backup and restore
+#
+
+load helpers
+
+function teardown() {
+    for side in src dst; do
+        if [[ -d $PODMAN_TMPDIR/$side ]]; then
+            local opts="--root $PODMAN_TMPDIR/$side/root --runroot $PODMAN_TMPDIR/$side/runroot"
+            run_podman $opts pod rm -af -t 0
+            run_podman $opts rm -af -t 0
+            run_podman $opts volume rm -af
+            run_podman $opts rmi -af
+        fi
+    done
+    basic_teardown
+}
+
+@test "podman system backup and restore" {
+    skip_if_remote "system backup and restore are not supported on the remote client"
+
+    # Back up and restore separate stores to simulate moving to a fresh host.
+    local src="--root $PODMAN_TMPDIR/src/root --runroot $PODMAN_TMPDIR/src/runroot"
+    local dst="--root $PODMAN_TMPDIR/dst/root --runroot $PODMAN_TMPDIR/dst/runroot"
+
+    run_podman save -o $PODMAN_TMPDIR/image.tar $IMAGE
+    run_podman $src load -i $PODMAN_TMPDIR/image.tar
+
+    local pname=p-$(safename)
+    local cname=c-$(safename)
+    local vname=v-$(safename)
+    run_podman $src volume create --label test=backup $vname
+    run_podman $src run --rm -v $vname:/vol $IMAGE sh -c "echo hello > /vol/file"
+    run_podman $src pod create --name $pname
+    run_podman $src create --pod $pname --name $cname -v $vname:/vol $IMAGE cat /vol/file
+    run_podman $src inspect --format "{{.ID}}" $cname
+    local cid="$output"
+
+    run_podman $src system backup --images -o $PODMAN_TMPDIR/backup.tar
+    assert "$output" =~ "Backed up 1 pods, 2 containers, 1 volumes" "backup report"
+
+    run_podman $dst system restore -i $PODMAN_TMPDIR/backup.tar
+    assert "$output" =~ "Restored 1 pods, 2 containers, 1 volumes" "restore report"
+
+    run_podman $dst pod ps --format "{{.Name}} {{.NumberOfContainers}}"
+    assert "$output" == "$pname 2" "restored pod"
+    run_podman $dst inspect --format "{{.ID}} {{.Pod}}" $cname
+    assert "$output" =~ "^$cid [0-9a-f]{64}$" "restored container keeps its ID"
+    run_podman $dst volume inspect --format "{{.Labels.test}}" $vname
+    assert "$output" == "backup" "restored volume"
+
+    run_podman $dst start -a $cname
+    assert "$output" == "hello" "restored volume data"
+
+    # Objects existing already are not overwritten.
+    run_podman 125 $dst system restore -i $PODMAN_TMPDIR/backup.tar
+    assert "$output" =~ "restoring pod $pname" "restoring existing pod fails"
+}
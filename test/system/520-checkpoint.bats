@@ -462,4 +462,33 @@ function setup() {
     run_podman rm -t 0 -f $ctrID
 }
 
+@test "podman container migrate - restores locally on failure" {
+    skip_if_remote "podman-remote does not support container migrate"
+
+    # Can we ssh to localhost?
+    run ssh -q -o BatchMode=yes \
+        -o UserKnownHostsFile=/dev/null \
+        -o StrictHostKeyChecking=no \
+        -o CheckHostIP=no \
+        root@localhost true
+    test "$status" -eq 0 || skip "cannot ssh to localhost"
+
+    run_podman run -d $IMAGE sh -c 'while :;do cat /proc/uptime; sleep 0.1;done'
+    local cid="$output"
+    wait_for_output '[1-9]\+' $cid
+
+    # The container is still known on the target host, which is this host,
+    # so it cannot be restored there after the chained pre-dumps and must be
+    # restored locally again.
+    run_podman 125 container migrate --pre-dumps 2 $cid root@localhost
+    assert "$output" =~ "restoring container $cid on root@localhost" \
+           "migrate fails to restore on the target host"
+
+    run_podman container inspect \
+               --format '{{.State.Status}}:{{.State.Running}}:{{.State.Checkpointed}}' $cid
+    is "$output" "running:true:false" "container is restored locally"
+
+    run_podman rm -t 0 -f $cid
+}
+
 # vim: filetype=sh
@@ -602,6 +602,167 @@ EOF
     run_podman kube down $TESTYAML
 }
 
+@test "podman kube play - statefulset" {
+    local name="s-$(safename)"
+    cat >$PODMAN_TMPDIR/sts.yaml <<EOF
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: $name
+spec:
+  serviceName: $name
+  replicas: 2
+  selector:
+    matchLabels:
+      app: $name
+  template:
+    metadata:
+      labels:
+        app: $name
+    spec:
+      containers:
+      - name: ctr
+        image: $IMAGE
+        command:
+        - top
+        volumeMounts:
+        - name: data
+          mountPath: /data
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+    spec:
+      accessModes: [ "ReadWriteOnce" ]
+      resources:
+        requests:
+          storage: 1Gi
+EOF
+
+    run_podman kube play $PODMAN_TMPDIR/sts.yaml
+
+    for i in 0 1; do
+        run_podman pod inspect $name-$i --format "{{.State}}"
+        is "$output" "Running" "pod $name-$i is running"
+
+        run_podman exec $name-$i-ctr hostname
+        is "$output" "$name-$i" "stable hostname of replica $i"
+
+        run_podman exec $name-$i-ctr sh -c "echo $i > /data/ordinal"
+        run_podman volume exists data-$name-$i
+    done
+
+    # Volumes outlive the pods and are reused by the same replica
+    run_podman kube down $PODMAN_TMPDIR/sts.yaml
+    run_podman kube play $PODMAN_TMPDIR/sts.yaml
+    for i in 0 1; do
+        run_podman exec $name-$i-ctr cat /data/ordinal
+        is "$output" "$i" "replica $i kept its volume"
+    done
+
+    run_podman kube down --force $PODMAN_TMPDIR/sts.yaml
+    run_podman 1 volume exists data-$name-0
+}
+
+@test "podman kube play - statefulset --ready-timeout" {
+    local name="s-$(safename)"
+    cat >$PODMAN_TMPDIR/sts.yaml <<EOF
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: $name
+spec:
+  serviceName: $name
+  replicas: 2
+  selector:
+    matchLabels:
+      app: $name
+  template:
+    metadata:
+      labels:
+        app: $name
+    spec:
+      containers:
+      - name: ctr
+        image: $IMAGE
+        command:
+        - top
+        livenessProbe:
+          exec:
+            command:
+            - "false"
+          periodSeconds: 1
+          failureThreshold: 1000
+EOF
+
+    # The first replica never becomes healthy, the second must not be started
+    run_podman 125 kube play --ready-timeout 3s $PODMAN_TMPDIR/sts.yaml
+    assert "$output" =~ "waiting for pod $name-0 to become ready: container $name-0-ctr did not become healthy within 3s" \
+           "play fails once the ready timeout expires"
+    run_podman 1 pod exists $name-1
+
+    run_podman kube down $PODMAN_TMPDIR/sts.yaml
+}
+
+@test "podman kube play - cronjob" {
+    skip_if_remote "CronJobs are not supported on the remote client"
+    local name="c-$(safename)"
+    cat >$PODMAN_TMPDIR/cronjob.yaml <<EOF
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: $name
+spec:
+  schedule: "0 0 1 1 *"
+  suspend: true
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: Never
+          containers:
+          - name: ctr
+            image: $IMAGE
+            command:
+            - printenv
+            - GREETING
+            envFrom:
+            - configMapRef:
+                name: $name-cm
+EOF
+    cat >$PODMAN_TMPDIR/configmap.yaml <<EOF
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: $name-cm
+data:
+  GREETING: hello
+EOF
+
+    # Runs use the options of kube play
+    run_podman kube play --configmap $PODMAN_TMPDIR/configmap.yaml $PODMAN_TMPDIR/cronjob.yaml
+    assert "$output" =~ "CronJobs:.*$name" "play reports the cronjob"
+
+    # Nothing runs until the schedule fires
+    run_podman pod ps --filter label=io.podman.kube.cronjob=$name --format "{{.Name}}"
+    assert "$output" == "" "no run of the cronjob yet"
+
+    # Run the cronjob manually the way the timer does
+    run_podman kube run-cronjob $name
+    run_podman pod ps --filter label=io.podman.kube.cronjob=$name --format "{{.Name}}"
+    assert "$output" =~ "^$name-[0-9]+-[0-9a-f]{5}-pod$" "run of the cronjob"
+    local pod="$output"
+    run_podman wait $pod-ctr
+    run_podman logs $pod-ctr
+    is "$output" "hello" "output of the run"
+
+    run_podman kube down $PODMAN_TMPDIR/cronjob.yaml
+    run_podman pod ps --filter label=io.podman.kube.cronjob=$name --format "{{.Name}}"
+    assert "$output" == "" "kube down removes the runs"
+
+    run_podman 125 kube run-cronjob $name
+    assert "$output" =~ "no cronjob $name has been played"
+}
+
 # bats test_tags=ci:parallel
 @test "podman kube generate filetype" {
     YAML=$PODMAN_TMPDIR/test.yml
@@ -247,4 +247,43 @@ metadata.name              | =  | ${pname}-job
     run_podman pod rm $pname
 }
 
+@test "podman kube generate - statefulset" {
+    local pname=p-$(safename)
+    local cname=c-$(safename)
+    local vname=v-$(safename)
+
+    run_podman pod create --name $pname
+    run_podman container create --name $cname --pod $pname -v $vname:/data $IMAGE top
+
+    run_podman kube generate --type statefulset --replicas 2 $pname
+
+    json=$(yaml2json <<<"$output")
+    # For debugging purposes in the event we regress, we can see the generate output to know what went wrong
+    jq . <<<"$json"
+
+    # See container test above for description of this table
+    expect="
+apiVersion | =  | apps/v1
+kind       | =  | StatefulSet
+
+metadata.labels.app        | =  | ${pname}
+metadata.name              | =  | ${pname}-statefulset
+
+spec.replicas                                         | =  | 2
+spec.serviceName                                      | =  | ${pname}
+spec.template.spec.volumes                            | =  | null
+spec.volumeClaimTemplates[0].metadata.name            | =  | ${vname}-pvc
+spec.template.spec.containers[0].volumeMounts[0].name | =  | ${vname}-pvc
+"
+
+    while read key op expect; do
+        actual=$(jq -r -c ".$key" <<<"$json")
+        assert "$actual" $op "$expect" ".$key"
+    done < <(parse_table "$expect")
+
+    run_podman rm $cname
+    run_podman pod rm $pname
+    run_podman volume rm $vname
+}
+
 # vim: filetype=sh